@@ -16,7 +16,8 @@ func main() {
 	// LogN:14, LogQP: 431.
 	//
 	// The ratio between the first prime of size ~2^{55} and the scaling factor 2^{45} is ~2^{10}.
-	// This means that these parameter can accommodate for values as large as 2^{9} (signed values).
+	// This means that these parameter can accommodate for values as large as 2^{9} (signed values),
+	// which can be queried with params.MaxMessageLog2(0).
 	// To be able to store larger values, either the scale has to be reduced or the first prime increased.
 	paramsLit := hefloat.ParametersLiteral{
 		LogN:            14,                                    // log2(ring degree)
@@ -91,9 +92,8 @@ func NewSchemeContext(paramsLit hefloat.ParametersLiteral) (sc *SchemeContext) {
 	// It is the responsibility of the user to ensure that the generated parameters meet the desired security level.
 	// This should be done using the lattice estimator (https://github.com/malb/lattice-estimator).
 	//
-	// The user can extract the base two logarithm of the modulus with the method [hefloat.Parameters].LogQP().
-	// See [rlwe.Parameters] and [hefloat.Parameters] for the related API to extract the necessary value for the
-	// estimator.
+	// The method [rlwe.Parameters].SecurityEstimate() returns the parameters of the underlying LWE instance
+	// in the format expected by the estimator, and its String() method prints a ready-to-run estimator snippet.
 	// >>>>>>>>>>>>>>>>>>>><<<<<<<<<<<<<<<<<<<<<
 
 	kgen := rlwe.NewKeyGenerator(params)
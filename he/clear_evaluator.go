@@ -0,0 +1,188 @@
+package he
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/Pro7ech/lattigo/utils"
+)
+
+// ClearEvaluator evaluates circuits on plaintext slices, mirroring the method names and
+// operand conventions of the homomorphic evaluators. It is intended for tests and
+// debugging: the reference circuit can be written once against the ClearEvaluator, using
+// the same structure as the homomorphic circuit, and its output compared to the decrypted
+// result of the homomorphic evaluation.
+//
+// The type parameter selects the plaintext domain: uint64 for [heint]-like circuits
+// (arithmetic carried out modulo Modulus) and complex128 for [hefloat]-like circuits
+// (Modulus is ignored).
+//
+// Rotations are cyclic over the whole slice: for schemes where the slots are split in
+// rows (e.g. the 2 x N/2 matrix of [heint]), evaluate each row on its own slice.
+type ClearEvaluator[T uint64 | complex128] struct {
+	// Modulus is the modulus under which the arithmetic is carried out.
+	// It is only used when T is uint64 and must then be non-zero.
+	// Values are expected to be reduced modulo Modulus.
+	Modulus uint64
+}
+
+// NewClearEvaluator instantiates a new ClearEvaluator. The argument modulus is only used
+// when T is uint64, and is then the modulus under which the arithmetic is carried out.
+func NewClearEvaluator[T uint64 | complex128](modulus uint64) *ClearEvaluator[T] {
+	return &ClearEvaluator[T]{Modulus: modulus}
+}
+
+func (eval ClearEvaluator[T]) add(a, b T) T {
+	switch a := any(a).(type) {
+	case uint64:
+		return any((a + any(b).(uint64)) % eval.Modulus).(T)
+	default:
+		return any(any(a).(complex128) + any(b).(complex128)).(T)
+	}
+}
+
+func (eval ClearEvaluator[T]) sub(a, b T) T {
+	switch a := any(a).(type) {
+	case uint64:
+		return any((a + eval.Modulus - any(b).(uint64)%eval.Modulus) % eval.Modulus).(T)
+	default:
+		return any(any(a).(complex128) - any(b).(complex128)).(T)
+	}
+}
+
+func (eval ClearEvaluator[T]) mul(a, b T) T {
+	switch a := any(a).(type) {
+	case uint64:
+		hi, lo := bits.Mul64(a, any(b).(uint64))
+		_, r := bits.Div64(hi%eval.Modulus, lo, eval.Modulus)
+		return any(r).(T)
+	default:
+		return any(any(a).(complex128) * any(b).(complex128)).(T)
+	}
+}
+
+// getVectorOperand expands op1 into a slice of size n: a scalar T is broadcast to all
+// slots, while a []T is returned as is after a size check.
+func (eval ClearEvaluator[T]) getVectorOperand(op1 interface{}, n int) (values []T, err error) {
+	switch op1 := op1.(type) {
+	case []T:
+		if len(op1) != n {
+			return nil, fmt.Errorf("invalid op1: len(op1)=%d != len(op0)=%d", len(op1), n)
+		}
+		return op1, nil
+	case T:
+		values = make([]T, n)
+		for i := range values {
+			values[i] = op1
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("invalid op1: must be %T or []%T but is %T", *new(T), *new(T), op1)
+	}
+}
+
+// Add evaluates opOut = op0 + op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) Add(op0 []T, op1 interface{}, opOut []T) (err error) {
+	values, err := eval.getVectorOperand(op1, len(op0))
+	if err != nil {
+		return fmt.Errorf("cannot Add: %w", err)
+	}
+	for i := range op0 {
+		opOut[i] = eval.add(op0[i], values[i])
+	}
+	return
+}
+
+// AddNew evaluates opOut = op0 + op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) AddNew(op0 []T, op1 interface{}) (opOut []T, err error) {
+	opOut = make([]T, len(op0))
+	return opOut, eval.Add(op0, op1, opOut)
+}
+
+// Sub evaluates opOut = op0 - op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) Sub(op0 []T, op1 interface{}, opOut []T) (err error) {
+	values, err := eval.getVectorOperand(op1, len(op0))
+	if err != nil {
+		return fmt.Errorf("cannot Sub: %w", err)
+	}
+	for i := range op0 {
+		opOut[i] = eval.sub(op0[i], values[i])
+	}
+	return
+}
+
+// SubNew evaluates opOut = op0 - op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) SubNew(op0 []T, op1 interface{}) (opOut []T, err error) {
+	opOut = make([]T, len(op0))
+	return opOut, eval.Sub(op0, op1, opOut)
+}
+
+// Mul evaluates opOut = op0 * op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) Mul(op0 []T, op1 interface{}, opOut []T) (err error) {
+	values, err := eval.getVectorOperand(op1, len(op0))
+	if err != nil {
+		return fmt.Errorf("cannot Mul: %w", err)
+	}
+	for i := range op0 {
+		opOut[i] = eval.mul(op0[i], values[i])
+	}
+	return
+}
+
+// MulNew evaluates opOut = op0 * op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) MulNew(op0 []T, op1 interface{}) (opOut []T, err error) {
+	opOut = make([]T, len(op0))
+	return opOut, eval.Mul(op0, op1, opOut)
+}
+
+// MulThenAdd evaluates opOut = opOut + op0 * op1. The following types are accepted for op1: T or []T.
+func (eval ClearEvaluator[T]) MulThenAdd(op0 []T, op1 interface{}, opOut []T) (err error) {
+	values, err := eval.getVectorOperand(op1, len(op0))
+	if err != nil {
+		return fmt.Errorf("cannot MulThenAdd: %w", err)
+	}
+	for i := range op0 {
+		opOut[i] = eval.add(opOut[i], eval.mul(op0[i], values[i]))
+	}
+	return
+}
+
+// Rotate evaluates opOut = op0 rotated by k positions to the left (cyclic over the whole slice).
+func (eval ClearEvaluator[T]) Rotate(op0 []T, k int, opOut []T) (err error) {
+	if len(op0) != len(opOut) {
+		return fmt.Errorf("cannot Rotate: len(op0)=%d != len(opOut)=%d", len(op0), len(opOut))
+	}
+	utils.RotateSliceAllocFree(op0, k, opOut)
+	return
+}
+
+// RotateNew evaluates opOut = op0 rotated by k positions to the left (cyclic over the whole slice).
+func (eval ClearEvaluator[T]) RotateNew(op0 []T, k int) (opOut []T, err error) {
+	opOut = make([]T, len(op0))
+	return opOut, eval.Rotate(op0, k, opOut)
+}
+
+// InnerSum evaluates opOut[i] = sum_{j=0}^{n-1} op0[(i + j*batch) mod len(op0)], i.e. the
+// aggregation of n sub-vectors of size batch, mirroring the InnerSum method of the
+// homomorphic evaluators.
+func (eval ClearEvaluator[T]) InnerSum(op0 []T, batch, n int, opOut []T) (err error) {
+	if len(op0) != len(opOut) {
+		return fmt.Errorf("cannot InnerSum: len(op0)=%d != len(opOut)=%d", len(op0), len(opOut))
+	}
+	buf := make([]T, len(op0))
+	for i := range op0 {
+		acc := op0[i]
+		for j := 1; j < n; j++ {
+			// Euclidean modulus: batch can be negative (e.g. the plaintext
+			// reference of Replicate, which is InnerSum with -batch).
+			idx := (i + j*batch) % len(op0)
+			if idx < 0 {
+				idx += len(op0)
+			}
+			acc = eval.add(acc, op0[idx])
+		}
+		buf[i] = acc
+	}
+	copy(opOut, buf)
+	return
+}
@@ -0,0 +1,136 @@
+package he
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearEvaluator(t *testing.T) {
+
+	t.Run("Uint64", func(t *testing.T) {
+
+		T := uint64(65537)
+
+		eval := NewClearEvaluator[uint64](T)
+
+		n := 8
+
+		v0 := make([]uint64, n)
+		v1 := make([]uint64, n)
+		for i := range v0 {
+			v0[i] = uint64(i)
+			v1[i] = T - uint64(i) - 1
+		}
+
+		want := make([]uint64, n)
+
+		for i := range want {
+			want[i] = (v0[i] + v1[i]) % T
+		}
+		have, err := eval.AddNew(v0, v1)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		for i := range want {
+			want[i] = (v0[i] + T - v1[i]) % T
+		}
+		have, err = eval.SubNew(v0, v1)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		for i := range want {
+			want[i] = (v0[i] * v1[i]) % T
+		}
+		have, err = eval.MulNew(v0, v1)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		scalar := T >> 1
+		for i := range want {
+			want[i] = (v0[i] * scalar) % T
+		}
+		have, err = eval.MulNew(v0, scalar)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		k := 3
+		for i := range want {
+			want[i] = v0[(i+k)%n]
+		}
+		have, err = eval.RotateNew(v0, k)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		batch, m := 1, 4
+		for i := range want {
+			var acc uint64
+			for j := range m {
+				acc = (acc + v0[(i+j*batch)%n]) % T
+			}
+			want[i] = acc
+		}
+		require.NoError(t, eval.InnerSum(v0, batch, m, v0))
+		require.Equal(t, want, v0)
+
+		batch = -2
+		for i := range want {
+			var acc uint64
+			for j := range m {
+				idx := (i + j*batch) % n
+				if idx < 0 {
+					idx += n
+				}
+				acc = (acc + v0[idx]) % T
+			}
+			want[i] = acc
+		}
+		require.NoError(t, eval.InnerSum(v0, batch, m, v0))
+		require.Equal(t, want, v0)
+
+		_, err = eval.AddNew(v0, v1[:n-1])
+		require.Error(t, err)
+	})
+
+	t.Run("Complex128", func(t *testing.T) {
+
+		eval := NewClearEvaluator[complex128](0)
+
+		n := 8
+
+		v0 := make([]complex128, n)
+		v1 := make([]complex128, n)
+		for i := range v0 {
+			v0[i] = complex(float64(i), -float64(i))
+			v1[i] = complex(-float64(i), float64(2*i))
+		}
+
+		want := make([]complex128, n)
+
+		for i := range want {
+			want[i] = v0[i] + v1[i]
+		}
+		have, err := eval.AddNew(v0, v1)
+		require.NoError(t, err)
+		require.Equal(t, want, have)
+
+		for i := range want {
+			want[i] = v0[i] + v1[i]
+		}
+		require.NoError(t, eval.MulThenAdd(v0, v1, want))
+		have, err = eval.MulNew(v0, v1)
+		require.NoError(t, err)
+		for i := range want {
+			want[i] -= have[i]
+		}
+		for i := range want {
+			require.Equal(t, v0[i]+v1[i], want[i])
+		}
+
+		have, err = eval.RotateNew(v0, -1)
+		require.NoError(t, err)
+		for i := range have {
+			require.Equal(t, v0[(i-1+n)%n], have[i])
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// GaloisElementsForArgMax returns the list of Galois elements required by the method
+// [Evaluator.ArgMax] over a ciphertext with the given number of slots, i.e. the elements
+// for the rotations by the powers of two smaller than slots.
+func GaloisElementsForArgMax(params Parameters, slots int) (galEls []uint64) {
+
+	for s := 1; s < slots; s <<= 1 {
+		galEls = append(galEls, params.GaloisElement(s))
+	}
+
+	return
+}
+
+// ArgMax returns in opOut a one-hot indicator of the maximum slot of ct: the slot
+// holding the maximum is mapped to 1 and all the other slots to 0.
+//
+// The circuit first replicates the maximum in every slot with a tournament of
+// log2(slots) rounds, each rotating the running maximum by a power of two and
+// combining it with its rotated copy through [ComparisonEvaluator.Max], and then
+// extracts the indicator as step(ct - max + margin/2).
+//
+// The caller must provide a margin > 0 separating the maximum from all the other
+// slots: the indicator is guaranteed to be 1 (resp. 0) only for the slots whose
+// distance to the maximum is smaller (resp. larger) than margin/2, up to the
+// precision of the minimax composite sign polynomial. The margin absorbs the error
+// of the replicated maximum, without which the comparison ct - max = 0 in the
+// maximum slot would be resolved by the scheme noise, i.e. to an arbitrary value
+// in [0, 1].
+//
+// The constraints of [ComparisonEvaluator.Max] apply: the slots of ct must be real
+// and lie in [-0.5, 0.5], so that each pairwise sum is in [-1, 1].
+//
+// The evaluation consumes log2(slots)+1 evaluations of the minimax composite sign
+// polynomial, thus the provided [ComparisonEvaluator] should be instantiated with a
+// [he.Bootstrapper] unless the parameters provide enough levels. The necessary
+// Galois keys can be generated with the elements returned by
+// [GaloisElementsForArgMax], along with the relinearization key and, if the ring
+// type is [ring.Standard], the Galois key for the complex conjugation.
+func (eval Evaluator) ArgMax(cmp *ComparisonEvaluator, ct *rlwe.Ciphertext, margin float64, buf rlwe.HoistingBuffer, opOut *rlwe.Ciphertext) (err error) {
+
+	if ct.Degree() != 1 {
+		return fmt.Errorf("cannot ArgMax: invalid ciphertext: degree should be 1 but is %d", ct.Degree())
+	}
+
+	if margin <= 0 {
+		return fmt.Errorf("cannot ArgMax: margin must be strictly positive")
+	}
+
+	params := eval.Parameters()
+
+	slots := ct.Slots()
+
+	hoisted := params.MaxLevelP() > -1
+
+	// Tournament: after the round of shift s = 2^{i}, each slot j of m holds the
+	// maximum over the slots {j, ..., j + 2^{i+1} - 1} (cyclically), thus after
+	// log2(slots) rounds every slot holds the global maximum.
+	m := ct.Clone()
+	mRot := rlwe.NewCiphertext(params, 1, m.Level(), -1)
+
+	nbRescales := params.LevelsConsumedPerRescaling()
+
+	// refresh flushes the pending rescaling that the outputs of Max and Step carry
+	// (without which the scale would exceed the modulus of the remaining levels)
+	// and bootstraps c back to the default scale and a fresh level whenever the
+	// scale or the level would prevent the next comparison.
+	refresh := func(c *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
+
+		for c.Scale.Log2() >= params.DefaultScale().Log2()+1 && c.Level() >= nbRescales {
+			if err := eval.Rescale(c, c); err != nil {
+				return nil, err
+			}
+		}
+
+		if cmp.Bootstrapper != nil && (c.Level() < 2*nbRescales || c.Scale.Cmp(params.DefaultScale()) != 0) {
+			return cmp.Bootstrap(c)
+		}
+
+		return c, nil
+	}
+
+	for s := 1; s < slots; s <<= 1 {
+
+		mRot.ResizeQ(m.Level())
+
+		if hoisted {
+			eval.FillHoistingBuffer(m.Level(), params.MaxLevelP(), m.Q[1], m.IsNTT, buf)
+			err = eval.AutomorphismHoisted(m, buf, params.GaloisElement(s), mRot)
+		} else {
+			err = eval.Rotate(m, s, mRot)
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot ArgMax: cannot rotate by %d: %w", s, err)
+		}
+
+		if m, err = cmp.Max(m, mRot); err != nil {
+			return fmt.Errorf("cannot ArgMax: %w", err)
+		}
+
+		if m, err = refresh(m); err != nil {
+			return fmt.Errorf("cannot ArgMax: %w", err)
+		}
+	}
+
+	// diff = ct - max + margin/2, which is >= margin/2 in the slot holding the
+	// maximum and <= -margin/2 in the slots at distance at least margin from it
+	var diff *rlwe.Ciphertext
+	if diff, err = eval.SubNew(ct, m); err != nil {
+		return fmt.Errorf("cannot ArgMax: %w", err)
+	}
+
+	if err = eval.Add(diff, margin/2, diff); err != nil {
+		return fmt.Errorf("cannot ArgMax: %w", err)
+	}
+
+	// indicator = step(diff), i.e. 1 in the slot holding the maximum, 0 elsewhere
+	var indicator *rlwe.Ciphertext
+	if indicator, err = cmp.Step(diff); err != nil {
+		return fmt.Errorf("cannot ArgMax: %w", err)
+	}
+
+	if indicator, err = refresh(indicator); err != nil {
+		return fmt.Errorf("cannot ArgMax: %w", err)
+	}
+
+	opOut.ResizeQ(indicator.LevelQ())
+	opOut.ResizeDegree(indicator.Degree())
+	opOut.Copy(indicator)
+
+	return
+}
@@ -0,0 +1,66 @@
+package hefloat_test
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+	"github.com/stretchr/testify/require"
+)
+
+func testArgMax(tc *testContext, t *testing.T) {
+
+	params := tc.params
+	enc := tc.encryptorSk
+	ecd := tc.encoder
+	dec := tc.decryptor
+	kgen := tc.kgen
+	sk := tc.sk
+
+	t.Run(GetTestName(params, "Evaluator/ArgMax"), func(t *testing.T) {
+
+		values, _, ct := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.25, 0), t)
+
+		// Plants a unique maximum with a gap well above the precision
+		// of the underlying sign approximation
+		argmax := len(values) / 3
+
+		delta := make([]bignum.Complex, len(values))
+		for i := range delta {
+			delta[i].SetPrec(ecd.Prec())
+		}
+		delta[argmax][0].SetFloat64(0.45)
+		delta[argmax][0].Sub(&delta[argmax][0], &values[argmax][0])
+		values[argmax][0].SetFloat64(0.45)
+
+		require.NoError(t, tc.evaluator.Add(ct, delta, ct))
+
+		btp := bootstrapping.NewSecretKeyBootstrapper(params, sk)
+
+		galEls := hefloat.GaloisElementsForArgMax(params, ct.Slots())
+		if params.RingType() == ring.Standard {
+			galEls = append(galEls, params.GaloisElementForComplexConjugation())
+		}
+
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), kgen.GenGaloisKeysNew(galEls, sk)...))
+
+		cmp := hefloat.NewComparisonEvaluator(params, eval, btp)
+
+		buf := eval.NewHoistingBuffer(ct.Level(), params.MaxLevelP())
+
+		// The planted maximum is separated from the other slots by at least 0.2
+		require.Error(t, eval.ArgMax(cmp, ct, 0, buf, ct))
+		require.NoError(t, eval.ArgMax(cmp, ct, 0.2, buf, ct))
+
+		want := make([]bignum.Complex, len(values))
+		for i := range want {
+			want[i].SetPrec(ecd.Prec())
+		}
+		want[argmax][0].SetFloat64(1)
+
+		hefloat.VerifyTestVectors(params, ecd, dec, want, ct, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
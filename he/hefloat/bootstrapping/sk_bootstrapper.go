@@ -34,6 +34,14 @@ func (d *SecretKeyBootstrapper) Bootstrap(ct *rlwe.Ciphertext) (*rlwe.Ciphertext
 	if err := d.Decode(d.DecryptNew(ct), values); err != nil {
 		return nil, err
 	}
+
+	// The decoded imaginary parts only carry numerical residue if the
+	// ring is conjugate-invariant, and the encoder rejects them.
+	if d.IsRealOnly() {
+		for i := range values {
+			values[i][1].SetFloat64(0)
+		}
+	}
 	pt := hefloat.NewPlaintext(d.Parameters, d.MaxLevel())
 	pt.MetaData = ct.MetaData
 	pt.Scale = d.Parameters.DefaultScale()
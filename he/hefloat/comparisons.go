@@ -1,9 +1,11 @@
 package hefloat
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/Pro7ech/lattigo/he"
+	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
 	"github.com/Pro7ech/lattigo/utils/bignum"
 )
@@ -193,3 +195,200 @@ func (eval ComparisonEvaluator) stepdiff(op0, op1 *rlwe.Ciphertext) (stepdiff *r
 
 	return diff, nil
 }
+
+// Sign evaluates an approximation of the sign function, f(x) = 1 if x > 0, -1 if x < 0, else 0,
+// on op0 (whose values must lie in [-1, 1]) and returns the result on opOut.
+//
+// The approximation is the standard composite one: `iterations` successive evaluations of a
+// low-degree sign-refinement polynomial (see https://eprint.iacr.org/2019/1234.pdf).
+// Supported degrees are 3 (see [CoeffsSignX2Cheby]) and 7 (see [CoeffsSignX4Cheby]); each
+// iteration roughly doubles (respectively quadruples) the output precision, at the cost of
+// Depth(degree) * params.LevelsConsumedPerRescaling() levels.
+//
+// The evaluation is performed internally with a [hefloat.PolynomialEvaluator], thus the
+// receiver must have been instantiated with a relinearization key and, if the ring type is
+// [ring.Standard], with the Galois key for the complex conjugation.
+// The method returns an error if op0 does not have enough levels to carry out the evaluation;
+// for finer control (custom polynomials, bootstrapping between iterations), use the
+// [hefloat.ComparisonEvaluator] instead.
+func (eval *Evaluator) Sign(op0 *rlwe.Ciphertext, degree, iterations int, opOut *rlwe.Ciphertext) (err error) {
+
+	var coeffs []string
+	switch degree {
+	case 3:
+		coeffs = CoeffsSignX2Cheby
+	case 7:
+		coeffs = CoeffsSignX4Cheby
+	default:
+		return fmt.Errorf("invalid degree: must be 3 or 7 but is %d", degree)
+	}
+
+	if iterations < 1 {
+		return fmt.Errorf("invalid iterations: must be at least 1 but is %d", iterations)
+	}
+
+	coeffsStr := make([][]string, iterations)
+	for i := range coeffsStr {
+		coeffsStr[i] = coeffs
+	}
+
+	mcp := NewMinimaxCompositePolynomial(coeffsStr)
+
+	params := eval.Parameters()
+
+	if depth := mcp.MaxDepth() * len(mcp) * params.LevelsConsumedPerRescaling(); op0.Level() < depth {
+		return fmt.Errorf("cannot Sign: %w: op0.Level()=%d but the evaluation requires %d levels", rlwe.ErrInsufficientLevel, op0.Level(), depth)
+	}
+
+	polyEval := NewPolynomialEvaluator(params, eval)
+
+	out := op0.Clone()
+
+	for k := range mcp {
+
+		// If we use the regular CKKS (with complex values), we chose a scale to be
+		// half of the desired scale, so that (x + conj(x)/2) has the correct scale.
+		var targetScale rlwe.Scale
+		if params.RingType() == ring.Standard {
+			targetScale = params.DefaultScale().Div(rlwe.NewScale(2))
+		} else {
+			targetScale = params.DefaultScale()
+		}
+
+		if out, err = polyEval.Evaluate(out, &mcp[k], targetScale); err != nil {
+			return fmt.Errorf("evaluate polynomial: %w", err)
+		}
+
+		// Clean the imaginary part (else it tends to explode)
+		if params.RingType() == ring.Standard {
+
+			// Reassigns the scale back to the original one
+			out.Scale = out.Scale.Mul(rlwe.NewScale(2))
+
+			var outConj *rlwe.Ciphertext
+			if outConj, err = eval.ConjugateNew(out); err != nil {
+				return
+			}
+
+			if err = eval.Add(out, outConj, out); err != nil {
+				return
+			}
+		}
+
+		if k != len(mcp)-1 {
+			if err = eval.Rescale(out, out); err != nil {
+				return fmt.Errorf("eval.Rescale: %w", err)
+			}
+		}
+	}
+
+	opOut.ResizeQ(out.LevelQ())
+	opOut.ResizeDegree(out.Degree())
+	opOut.Copy(out)
+
+	return
+}
+
+// Max evaluates opOut = max(op0, op1) element-wise, using the identity
+// max(a, b) = (a+b)/2 + |a-b|/2 with |a-b| = (a-b) * sign(a-b), where the sign function
+// is approximated with [Evaluator.Sign] (degree and iterations have the same meaning and
+// constraints).
+//
+// The sign approximation requires op0 - op1 to lie in the interval [-1, 1] (e.g. both
+// operands in [-1/2, 1/2]) and its resolution around ties is bounded by the precision of
+// the approximation: operands closer to each other than the precision provided by
+// iterations are averaged rather than selected.
+//
+// op0 and op1 must have the same scale. The depth and the relinearization of the internal
+// product are managed internally: the evaluation consumes Depth(degree) * iterations + 1
+// rescalings worth of levels and the receiver must have been instantiated with a
+// relinearization key (and, if the ring type is [ring.Standard], with the Galois key for
+// the complex conjugation). The method returns an error if the operands do not have
+// enough levels to carry out the evaluation.
+func (eval *Evaluator) Max(op0, op1 *rlwe.Ciphertext, degree, iterations int, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.extremum(op0, op1, degree, iterations, true, opOut); err != nil {
+		return fmt.Errorf("cannot Max: %w", err)
+	}
+	return
+}
+
+// Min evaluates opOut = min(op0, op1) element-wise, using the identity
+// min(a, b) = (a+b)/2 - |a-b|/2 with |a-b| = (a-b) * sign(a-b), where the sign function
+// is approximated with [Evaluator.Sign] (degree and iterations have the same meaning and
+// constraints). See [Evaluator.Max] for the input-range assumptions, the key material
+// requirements and the level consumption of the evaluation.
+func (eval *Evaluator) Min(op0, op1 *rlwe.Ciphertext, degree, iterations int, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.extremum(op0, op1, degree, iterations, false, opOut); err != nil {
+		return fmt.Errorf("cannot Min: %w", err)
+	}
+	return
+}
+
+// extremum evaluates (op0+op1)/2 + |op0-op1|/2 if isMax, else (op0+op1)/2 - |op0-op1|/2.
+func (eval *Evaluator) extremum(op0, op1 *rlwe.Ciphertext, degree, iterations int, isMax bool, opOut *rlwe.Ciphertext) (err error) {
+
+	params := eval.Parameters()
+
+	if op0.Scale.Cmp(op1.Scale) != 0 {
+		return fmt.Errorf("%w: op0 and op1 must have the same scale", rlwe.ErrScaleMismatch)
+	}
+
+	// diff = op0 - op1
+	var diff *rlwe.Ciphertext
+	if diff, err = eval.SubNew(op0, op1); err != nil {
+		return
+	}
+
+	// sign = sign(op0 - op1)
+	sign := NewCiphertext(params, 1, diff.Level())
+	if err = eval.Sign(diff, degree, iterations, sign); err != nil {
+		return
+	}
+
+	// One more rescaling is required after the product by the sign.
+	if sign.Level() < params.LevelsConsumedPerRescaling() {
+		return fmt.Errorf("%w: op0.Level()=%d but the evaluation requires %d additional levels after the sign evaluation", rlwe.ErrInsufficientLevel, op0.Level(), params.LevelsConsumedPerRescaling())
+	}
+
+	// abs = |op0 - op1| = (op0 - op1) * sign(op0 - op1)
+	var abs *rlwe.Ciphertext
+	if abs, err = eval.MulRelinNew(diff, sign); err != nil {
+		return
+	}
+
+	// sum = op0 + op1
+	var sum *rlwe.Ciphertext
+	if sum, err = eval.AddNew(op0, op1); err != nil {
+		return
+	}
+
+	// Scales sum by sign.Scale (an integer) so that sum and abs carry exactly the same
+	// scale, then records a scale twice as large on both: this evaluates the two
+	// halvings of (a+b)/2 +/- |a-b|/2 for free.
+	if err = eval.Mul(sum, &sign.Scale.Value, sum); err != nil {
+		return
+	}
+
+	abs.Scale = abs.Scale.Mul(rlwe.NewScale(2))
+	sum.Scale = abs.Scale
+
+	if isMax {
+		err = eval.Add(sum, abs, abs)
+	} else {
+		err = eval.Sub(sum, abs, abs)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if err = eval.Rescale(abs, abs); err != nil {
+		return
+	}
+
+	opOut.ResizeQ(abs.LevelQ())
+	opOut.ResizeDegree(abs.Degree())
+	opOut.Copy(abs)
+
+	return
+}
@@ -57,6 +57,114 @@ func testComparisons(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "Evaluator/Sign"), func(t *testing.T) {
+
+		degree := 7
+
+		// Evaluates as many iterations as the parameters allow (depth 3 each)
+		iterations := params.MaxLevel() / (3 * params.LevelsConsumedPerRescaling())
+
+		values, _, ct := newTestVectors(tc, enc, complex(-1, 0), complex(1, 0), t)
+
+		require.NoError(t, eval.Sign(ct, degree, iterations, ct))
+
+		have := make([]big.Float, params.MaxSlots())
+
+		require.NoError(t, ecd.Decode(dec.DecryptNew(ct), have))
+
+		refCoeffs := make([][]string, iterations)
+		for i := range refCoeffs {
+			refCoeffs[i] = hefloat.CoeffsSignX4Cheby
+		}
+
+		refPoly := hefloat.NewMinimaxCompositePolynomial(refCoeffs)
+
+		want := make([]big.Float, params.MaxSlots())
+
+		for i := range have {
+			want[i] = refPoly.Evaluate(values[i])[0]
+		}
+
+		hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Not enough levels
+		ctLow := ct.Clone()
+		ctLow.ResizeQ(0)
+		require.Error(t, eval.Sign(ctLow, degree, iterations, ctLow))
+
+		// Unsupported degree
+		require.Error(t, eval.Sign(ct, 5, iterations, ct))
+	})
+
+	for _, isMax := range []bool{true, false} {
+
+		name := "Evaluator/Max"
+		if !isMax {
+			name = "Evaluator/Min"
+		}
+
+		t.Run(GetTestName(params, name), func(t *testing.T) {
+
+			degree := 7
+
+			// Evaluates as many iterations as the parameters allow (depth 3 each),
+			// keeping one level for the final rescaling
+			iterations := (params.MaxLevel() - params.LevelsConsumedPerRescaling()) / (3 * params.LevelsConsumedPerRescaling())
+
+			values0, _, ct0 := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+			values1, _, ct1 := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+
+			// Mismatched scales must be rejected
+			ctBadScale := ct1.Clone()
+			ctBadScale.Scale = ct1.Scale.Mul(rlwe.NewScale(2))
+			require.ErrorIs(t, eval.Max(ct0, ctBadScale, degree, iterations, ctBadScale), rlwe.ErrScaleMismatch)
+
+			if isMax {
+				require.NoError(t, eval.Max(ct0, ct1, degree, iterations, ct0))
+			} else {
+				require.NoError(t, eval.Min(ct0, ct1, degree, iterations, ct0))
+			}
+
+			have := make([]big.Float, params.MaxSlots())
+
+			require.NoError(t, ecd.Decode(dec.DecryptNew(ct0), have))
+
+			refCoeffs := make([][]string, iterations)
+			for i := range refCoeffs {
+				refCoeffs[i] = hefloat.CoeffsSignX4Cheby
+			}
+
+			refPoly := hefloat.NewMinimaxCompositePolynomial(refCoeffs)
+
+			// (a+b)/2 +/- (a-b) * sign(a-b)/2
+			want := make([]big.Float, params.MaxSlots())
+
+			half := new(big.Float).SetFloat64(0.5)
+
+			for i := range have {
+
+				a, b := &values0[i][0], &values1[i][0]
+
+				diff := new(big.Float).Sub(a, b)
+
+				abs := refPoly.Evaluate(diff)[0]
+				abs.Mul(&abs, diff)
+
+				res := new(big.Float).Add(a, b)
+				if isMax {
+					res.Add(res, &abs)
+				} else {
+					res.Sub(res, &abs)
+				}
+				res.Mul(res, half)
+
+				want[i] = *res
+			}
+
+			hefloat.VerifyTestVectors(params, ecd, nil, want, have, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		})
+	}
+
 	t.Run(GetTestName(params, "ComparisonEvaluator/Step"), func(t *testing.T) {
 
 		values, _, ct := newTestVectors(tc, enc, complex(-1, 0), complex(1, 0), t)
@@ -34,6 +34,7 @@ func testDFTEvaluator(t *testing.T) {
 			for _, testSet := range []func(params hefloat.Parameters, logSlots int, t *testing.T){
 				testHomomorphicEncoding,
 				testHomomorphicDecoding,
+				testGaloisElementsForDFT,
 			} {
 				testSet(params, logSlots, t)
 				runtime.GC()
@@ -65,6 +66,38 @@ func testDFTMatrixLiteralMarshalling(t *testing.T) {
 	})
 }
 
+func testGaloisElementsForDFT(params hefloat.Parameters, LogSlots int, t *testing.T) {
+
+	t.Run("DFTEvaluator/GaloisElementsForDFT", func(t *testing.T) {
+
+		Levels := make([]int, min(LogSlots, 3))
+		for i := range Levels {
+			Levels[i] = 1
+		}
+
+		galEls := params.GaloisElementsForDFT(LogSlots, Levels)
+
+		// The helper must return a duplicate-free union of the elements
+		// required by the encode and decode schedules
+		require.ElementsMatch(t, utils.GetDistincts(galEls), galEls)
+
+		for _, dftType := range []hefloat.DFTType{hefloat.HomomorphicEncode, hefloat.HomomorphicDecode} {
+
+			m := hefloat.DFTMatrixLiteral{
+				Type:     dftType,
+				LogSlots: LogSlots,
+				LevelQ:   params.MaxLevel(),
+				LevelP:   params.MaxLevelP(),
+				Levels:   Levels,
+			}
+
+			for _, galEl := range m.GaloisElements(params) {
+				require.Contains(t, galEls, galEl)
+			}
+		}
+	})
+}
+
 func testHomomorphicEncoding(params hefloat.Parameters, LogSlots int, t *testing.T) {
 
 	slots := 1 << LogSlots
@@ -120,6 +120,13 @@ func (ecd Encoder) Parameters() Parameters {
 	return ecd.parameters
 }
 
+// IsRealOnly returns true if the underlying ring type is [ring.ConjugateInvariant],
+// in which case only real values can be encoded: complex inputs with a non-zero
+// imaginary part are rejected at encoding time.
+func (ecd Encoder) IsRealOnly() bool {
+	return ecd.parameters.RingType() == ring.ConjugateInvariant
+}
+
 func (ecd Encoder) GetRLWEParameters() rlwe.Parameters {
 	return ecd.parameters.Parameters
 }
@@ -128,7 +135,8 @@ func (ecd Encoder) GetRLWEParameters() rlwe.Parameters {
 // Encoding is done at the level and scale of the plaintext.
 // Encoding domain is done according to the metadata of the plaintext.
 // User must ensure that 1 <= len(values) <= 2^pt.LogMaxDimensions < 2^logN.
-// The imaginary part will be discarded if ringType == ring.ConjugateInvariant.
+// If ringType == ring.ConjugateInvariant, only real values are supported: an error
+// is returned if a value has a non-zero imaginary part (see [Encoder.IsRealOnly]).
 func (ecd Encoder) Encode(values FloatSlice, pt *rlwe.Plaintext) (err error) {
 
 	if pt.IsBatched {
@@ -162,11 +170,72 @@ func (ecd Encoder) Encode(values FloatSlice, pt *rlwe.Plaintext) (err error) {
 	return
 }
 
+// EncodeCoeffs encodes values directly on the coefficients of the plaintext,
+// bypassing the special Fourier transform of the slot encoding.
+// It sets pt.IsBatched = false: the resulting plaintext does not support
+// slot-wise operations (see the Coefficients encoding described in the
+// [Encoder] documentation), but up to N values can be encoded.
+// The accepted values.(type) are []float64 and []big.Float.
+func (ecd Encoder) EncodeCoeffs(values FloatSlice, pt *rlwe.Plaintext) (err error) {
+	pt.IsBatched = false
+	return ecd.Encode(values, pt)
+}
+
+// DecodeCoeffs decodes the coefficients of the input plaintext on values,
+// bypassing the special Fourier transform of the slot decoding.
+// Returns an error if the plaintext is encoded in the slot domain (i.e. if
+// pt.IsBatched = true).
+func (ecd Encoder) DecodeCoeffs(pt *rlwe.Plaintext, values FloatSlice) (err error) {
+	if pt.IsBatched {
+		return fmt.Errorf("cannot DecodeCoeffs: plaintext is encoded in the slot domain (pt.IsBatched = true)")
+	}
+	return ecd.Decode(pt, values)
+}
+
 // Decode decodes the input plaintext on a new FloatSlice.
 func (ecd Encoder) Decode(pt *rlwe.Plaintext, values FloatSlice) (err error) {
 	return ecd.DecodePublic(pt, values, 0)
 }
 
+// DecodeFloat32 decodes the input plaintext on a []float32.
+// The decoding itself is carried out in float64 precision and each value is then
+// rounded to the nearest float32, which keeps only the 24 most significant bits
+// of the mantissa: the result carries at most ~7 significant decimal digits,
+// regardless of the precision of the encoder.
+func (ecd Encoder) DecodeFloat32(pt *rlwe.Plaintext, values []float32) (err error) {
+	return ecd.DecodePublic(pt, values, 0)
+}
+
+// DecodeRange decodes only the slots [start, start+n) of the input plaintext into out,
+// which must have space for at least n values.
+//
+// Cost model: the decoding is an inverse FFT over all the slots of the plaintext, so the
+// full transform is still computed internally and a scratch slice covering all the slots
+// is allocated; only the final copy is restricted to the requested range. The method is a
+// convenience for partial reads of large packed vectors, not a performance optimization.
+func (ecd Encoder) DecodeRange(pt *rlwe.Plaintext, start, n int, out []float64) (err error) {
+
+	slots := 1 << pt.LogDimensions.Cols
+
+	if start < 0 || n < 0 || start+n > slots {
+		return fmt.Errorf("cannot DecodeRange: invalid slot range [%d, %d): must lie in [0, %d)", start, start+n, slots)
+	}
+
+	if len(out) < n {
+		return fmt.Errorf("cannot DecodeRange: len(out)=%d but the requested range has %d slots", len(out), n)
+	}
+
+	buff := make([]float64, slots)
+
+	if err = ecd.Decode(pt, buff); err != nil {
+		return
+	}
+
+	copy(out[:n], buff[start:start+n])
+
+	return
+}
+
 // DecodePublic decodes the input plaintext on a FloatSlice.
 // It adds, before the decoding step (i.e. in the Ring) noise that follows the given distribution parameters.
 // If the underlying ringType is ConjugateInvariant, the imaginary part (and its related error) are zero.
@@ -178,7 +247,8 @@ func (ecd Encoder) DecodePublic(pt *rlwe.Plaintext, values FloatSlice, logprec f
 // This method it as the core of the slot encoding.
 // Values are encoded according to the provided metadata.
 // Accepted polyOut.(type) are rlwe.Point and ring.RNSPoly.
-// The imaginary part will be discarded if ringType == ring.ConjugateInvariant.
+// If ringType == ring.ConjugateInvariant, only real values are supported: an error
+// is returned if a value has a non-zero imaginary part (see [Encoder.IsRealOnly]).
 func (ecd Encoder) Embed(values interface{}, metadata *rlwe.MetaData, polyOut interface{}) (err error) {
 	if ecd.prec <= 53 {
 		return ecd.embedDouble(values, metadata, polyOut)
@@ -213,6 +283,9 @@ func (ecd Encoder) embedDouble(values FloatSlice, metadata *rlwe.MetaData, polyO
 
 		if ecd.parameters.RingType() == ring.ConjugateInvariant {
 			for i := range values {
+				if imag(values[i]) != 0 {
+					return fmt.Errorf("cannot Embed: ringType is ConjugateInvariant, which only supports real values, but values[%d] has a non-zero imaginary part", i)
+				}
 				buffCmplx[i] = complex(real(values[i]), 0)
 			}
 		} else {
@@ -229,6 +302,9 @@ func (ecd Encoder) embedDouble(values FloatSlice, metadata *rlwe.MetaData, polyO
 
 		if ecd.parameters.RingType() == ring.ConjugateInvariant {
 			for i := range values {
+				if values[i][1].Sign() != 0 {
+					return fmt.Errorf("cannot Embed: ringType is ConjugateInvariant, which only supports real values, but values[%d] has a non-zero imaginary part", i)
+				}
 				f64, _ := values[i][0].Float64()
 				buffCmplx[i] = complex(f64, 0)
 			}
@@ -250,6 +326,30 @@ func (ecd Encoder) embedDouble(values FloatSlice, metadata *rlwe.MetaData, polyO
 			buffCmplx[i] = complex(values[i], 0)
 		}
 
+	case []float32:
+
+		lenValues = len(values)
+
+		if maxCols := ecd.parameters.MaxDimensions().Cols; lenValues > maxCols || lenValues > slots {
+			return fmt.Errorf("cannot Embed: ensure that #values (%d) <= slots (%d) <= maxCols (%d)", len(values), slots, maxCols)
+		}
+
+		for i := range values {
+			buffCmplx[i] = complex(float64(values[i]), 0)
+		}
+
+	case []int32:
+
+		lenValues = len(values)
+
+		if maxCols := ecd.parameters.MaxDimensions().Cols; lenValues > maxCols || lenValues > slots {
+			return fmt.Errorf("cannot Embed: ensure that #values (%d) <= slots (%d) <= maxCols (%d)", len(values), slots, maxCols)
+		}
+
+		for i := range values {
+			buffCmplx[i] = complex(float64(values[i]), 0)
+		}
+
 	case []big.Float:
 
 		lenValues = len(values)
@@ -263,7 +363,7 @@ func (ecd Encoder) embedDouble(values FloatSlice, metadata *rlwe.MetaData, polyO
 			buffCmplx[i] = complex(f64, 0)
 		}
 	default:
-		return fmt.Errorf("cannot Embed: values.(Type) must be []complex128, []bignum.Complex, []float64 or []big.Float, but is %T", values)
+		return fmt.Errorf("cannot Embed: values.(Type) must be []complex128, []bignum.Complex, []float64, []float32, []int32 or []big.Float, but is %T", values)
 	}
 
 	// Zeroes all other values
@@ -322,6 +422,9 @@ func (ecd Encoder) embedArbitrary(values FloatSlice, metadata *rlwe.MetaData, po
 
 		if ecd.parameters.RingType() == ring.ConjugateInvariant {
 			for i := range values {
+				if imag(values[i]) != 0 {
+					return fmt.Errorf("cannot Embed: ringType is ConjugateInvariant, which only supports real values, but values[%d] has a non-zero imaginary part", i)
+				}
 				buffCmplx[i][0].SetFloat64(real(values[i]))
 				buffCmplx[i][1].SetFloat64(0)
 			}
@@ -342,6 +445,9 @@ func (ecd Encoder) embedArbitrary(values FloatSlice, metadata *rlwe.MetaData, po
 
 		if ecd.parameters.RingType() == ring.ConjugateInvariant {
 			for i := range values {
+				if values[i][1].Sign() != 0 {
+					return fmt.Errorf("cannot Embed: ringType is ConjugateInvariant, which only supports real values, but values[%d] has a non-zero imaginary part", i)
+				}
 				buffCmplx[i][0].Set(&values[i][0])
 				buffCmplx[i][1].SetFloat64(0)
 			}
@@ -364,6 +470,32 @@ func (ecd Encoder) embedArbitrary(values FloatSlice, metadata *rlwe.MetaData, po
 			buffCmplx[i][1].SetFloat64(0)
 		}
 
+	case []float32:
+
+		lenValues = len(values)
+
+		if maxCols := ecd.parameters.MaxDimensions().Cols; lenValues > maxCols || lenValues > slots {
+			return fmt.Errorf("cannot Embed: ensure that #values (%d) <= slots (%d) <= maxCols (%d)", len(values), slots, maxCols)
+		}
+
+		for i := range values {
+			buffCmplx[i][0].SetFloat64(float64(values[i]))
+			buffCmplx[i][1].SetFloat64(0)
+		}
+
+	case []int32:
+
+		lenValues = len(values)
+
+		if maxCols := ecd.parameters.MaxDimensions().Cols; lenValues > maxCols || lenValues > slots {
+			return fmt.Errorf("cannot Embed: ensure that #values (%d) <= slots (%d) <= maxCols (%d)", len(values), slots, maxCols)
+		}
+
+		for i := range values {
+			buffCmplx[i][0].SetInt64(int64(values[i]))
+			buffCmplx[i][1].SetFloat64(0)
+		}
+
 	case []big.Float:
 
 		lenValues = len(values)
@@ -377,7 +509,7 @@ func (ecd Encoder) embedArbitrary(values FloatSlice, metadata *rlwe.MetaData, po
 			buffCmplx[i][1].SetFloat64(0)
 		}
 	default:
-		return fmt.Errorf("cannot Embed: values.(Type) must be []complex128, []bignum.Complex, []float64 or []big.Float, but is %T", values)
+		return fmt.Errorf("cannot Embed: values.(Type) must be []complex128, []bignum.Complex, []float64, []float32, []int32 or []big.Float, but is %T", values)
 	}
 
 	// Zeroes all other values
@@ -437,6 +569,23 @@ func (ecd Encoder) plaintextToFloat(level int, scale rlwe.Scale, logSlots int, p
 // The method will add a flooding noise before the decoding process following the defined distribution if it is not nil.
 func (ecd Encoder) decodePublic(pt *rlwe.Plaintext, values FloatSlice, logprec float64) (err error) {
 
+	// []float32 is decoded in float64 precision and each value is then rounded
+	// to the nearest float32.
+	if values32, ok := values.([]float32); ok {
+
+		buff := make([]float64, len(values32))
+
+		if err = ecd.decodePublic(pt, buff, logprec); err != nil {
+			return
+		}
+
+		for i := range values32 {
+			values32[i] = float32(buff[i])
+		}
+
+		return
+	}
+
 	logSlots := pt.LogDimensions.Cols
 	slots := 1 << logSlots
 
@@ -453,7 +602,7 @@ func (ecd Encoder) decodePublic(pt *rlwe.Plaintext, values FloatSlice, logprec f
 	switch values.(type) {
 	case []complex128, []float64, []bignum.Complex, []big.Float:
 	default:
-		return fmt.Errorf("cannot decode: values.(type) accepted are []complex128, []float64, []bignum.Complex, []big.Float but is %T", values)
+		return fmt.Errorf("cannot decode: values.(type) accepted are []complex128, []float64, []float32, []bignum.Complex, []big.Float but is %T", values)
 	}
 
 	if pt.IsBatched {
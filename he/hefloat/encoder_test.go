@@ -42,6 +42,71 @@ func testEncoder(tc *testContext, t *testing.T) {
 		require.GreaterOrEqual(t, hefloat.StandardDeviation(want, rlwe.NewScale(1)), math.Exp2(-logprec)/math.Sqrt(12)*0.9)
 	})
 
+	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true/DecodeRange"), func(t *testing.T) {
+
+		values, plaintext, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+
+		full := make([]float64, len(values))
+		require.NoError(t, tc.encoder.Decode(plaintext, full))
+
+		start := len(values) >> 2
+		n := len(values) >> 1
+
+		have := make([]float64, n)
+		require.NoError(t, tc.encoder.DecodeRange(plaintext, start, n, have))
+		require.Equal(t, full[start:start+n], have)
+
+		// Out-of-range and undersized output slices must error
+		require.Error(t, tc.encoder.DecodeRange(plaintext, -1, n, have))
+		require.Error(t, tc.encoder.DecodeRange(plaintext, len(values), 1, have))
+		require.Error(t, tc.encoder.DecodeRange(plaintext, 0, n, make([]float64, n-1)))
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true/[]float32"), func(t *testing.T) {
+
+		r := sampling.NewSource([32]byte{})
+
+		values := make([]float32, tc.params.MaxSlots())
+		for i := range values {
+			values[i] = float32(r.Float64(-1, 1))
+		}
+
+		pt := hefloat.NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(values, pt))
+
+		have := make([]float32, len(values))
+		require.NoError(t, tc.encoder.DecodeFloat32(pt, have))
+
+		want := make([]float64, len(values))
+		for i := range want {
+			want[i] = float64(values[i] - have[i])
+		}
+
+		// The error is dominated by the float32 rounding of the decoding
+		minprec := math.Min(float64(tc.params.LogDefaultScale()), 24)
+		require.GreaterOrEqual(t, math.Log2(1/(hefloat.StandardDeviation(want, rlwe.NewScale(1))+1e-30)), minprec-1)
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true/[]int32"), func(t *testing.T) {
+
+		r := sampling.NewSource([32]byte{})
+
+		values := make([]int32, tc.params.MaxSlots())
+		for i := range values {
+			values[i] = int32(r.Uint64()%(1<<20)) - (1 << 19)
+		}
+
+		pt := hefloat.NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(values, pt))
+
+		have := make([]float64, len(values))
+		require.NoError(t, tc.encoder.Decode(pt, have))
+
+		for i := range values {
+			require.Equal(t, float64(values[i]), math.Round(have[i]))
+		}
+	})
+
 	t.Run(GetTestName(tc.params, "Encoder/IsBatched=true/DecodePublic/[]complex128"), func(t *testing.T) {
 
 		if tc.params.RingType() == ring.ConjugateInvariant {
@@ -106,6 +171,69 @@ func testEncoder(tc *testContext, t *testing.T) {
 		require.GreaterOrEqual(t, hefloat.StandardDeviation(wantImag, rlwe.NewScale(1)), math.Exp2(-logprec)/math.Sqrt(12)*0.9)
 	})
 
+	t.Run(GetTestName(tc.params, "Encoder/IsRealOnly"), func(t *testing.T) {
+
+		require.Equal(t, tc.params.RingType() == ring.ConjugateInvariant, tc.encoder.IsRealOnly())
+
+		pt := hefloat.NewPlaintext(tc.params, tc.params.MaxLevel())
+
+		cmplx128 := make([]complex128, tc.params.MaxSlots())
+		cmplxBig := make([]bignum.Complex, tc.params.MaxSlots())
+		cmplx128[1] = 1i
+		cmplxBig[1][1].SetFloat64(1)
+
+		if tc.encoder.IsRealOnly() {
+
+			// Complex inputs with a non-zero imaginary part must be rejected
+			require.ErrorContains(t, tc.encoder.Encode(cmplx128, pt), "imaginary")
+			require.ErrorContains(t, tc.encoder.Encode(cmplxBig, pt), "imaginary")
+
+			// But real-valued complex inputs remain accepted
+			cmplx128[1] = 1
+			cmplxBig[1][0].SetFloat64(1)
+			cmplxBig[1][1].SetFloat64(0)
+		}
+
+		require.NoError(t, tc.encoder.Encode(cmplx128, pt))
+		require.NoError(t, tc.encoder.Encode(cmplxBig, pt))
+	})
+
+	t.Run(GetTestName(tc.params, "Encoder/Coeffs"), func(t *testing.T) {
+
+		values := make([]float64, tc.params.N())
+
+		r := sampling.NewSource([32]byte{})
+
+		for i := range values {
+			values[i] = r.Float64(-1, 1)
+		}
+
+		pt := hefloat.NewPlaintext(tc.params, tc.params.MaxLevel())
+
+		require.NoError(t, tc.encoder.EncodeCoeffs(values, pt))
+		require.False(t, pt.IsBatched)
+
+		have := make([]float64, len(values))
+		require.NoError(t, tc.encoder.DecodeCoeffs(pt, have))
+
+		var meanprec float64
+		for i := range values {
+			meanprec += math.Abs(have[i] - values[i])
+		}
+		meanprec /= float64(len(values))
+
+		minPrec := math.Log2(tc.params.DefaultScale().Float64()) - float64(tc.params.LogN()+2)
+		if minPrec < 0 {
+			minPrec = 0
+		}
+
+		require.GreaterOrEqual(t, math.Log2(1/meanprec), minPrec)
+
+		// DecodeCoeffs must refuse a plaintext encoded in the slot domain
+		_, ptBatched, _ := newTestVectors(tc, nil, -1-1i, 1+1i, t)
+		require.Error(t, tc.encoder.DecodeCoeffs(ptBatched, have))
+	})
+
 	t.Run(GetTestName(tc.params, "Encoder/IsBatched=false"), func(t *testing.T) {
 
 		slots := tc.params.N()
@@ -169,4 +297,41 @@ func testEncoder(tc *testContext, t *testing.T) {
 
 		require.GreaterOrEqual(t, math.Log2(1/meanprec), minPrec)
 	})
+
+	t.Run(GetTestName(tc.params, "Encoder/Window"), func(t *testing.T) {
+
+		size := 64
+
+		we, err := hefloat.NewWindowEncoder(tc.encoder, size, tc.params.MaxLevel(), tc.params.DefaultScale())
+		require.NoError(t, err)
+
+		r := sampling.NewSource([32]byte{})
+
+		window := make([]float64, size)
+
+		// Streams more values than the window holds, so that every
+		// slot of the initial all-zero window is overwritten at least once
+		for i := 0; i < size+17; i++ {
+			value := r.Float64(-1, 1)
+			copy(window, window[1:])
+			window[size-1] = value
+			we.Push(value)
+		}
+
+		pt := we.GetPlaintextNew()
+
+		// The incremental encoding must be identical to a full re-encoding of the window
+		ptWant := hefloat.NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.EncodeCoeffs(window, ptWant))
+
+		require.Equal(t, ptWant.Q, pt.Q)
+		require.Equal(t, ptWant.IsBatched, pt.IsBatched)
+		require.Equal(t, ptWant.IsNTT, pt.IsNTT)
+
+		// The window size must be in [1, N]
+		_, err = hefloat.NewWindowEncoder(tc.encoder, 0, tc.params.MaxLevel(), tc.params.DefaultScale())
+		require.Error(t, err)
+		_, err = hefloat.NewWindowEncoder(tc.encoder, tc.params.N()+1, tc.params.MaxLevel(), tc.params.DefaultScale())
+		require.Error(t, err)
+	})
 }
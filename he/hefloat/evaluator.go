@@ -3,6 +3,7 @@ package hefloat
 import (
 	"fmt"
 	"math/big"
+	"math/cmplx"
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -36,6 +37,24 @@ func (eval *Evaluator) LevelsConsumedPerRescaling() int {
 	return eval.Parameters().LevelsConsumedPerRescaling()
 }
 
+// checkNTTDomain verifies that the operands that are an [rlwe.Element] are in the NTT domain
+// expected by the parameters, returning an explicit error if one of them is not, instead of
+// letting the operation silently compute garbage.
+func (eval *Evaluator) checkNTTDomain(ops ...rlwe.Operand) (err error) {
+	NTTFlag := eval.Parameters().NTTFlag()
+	for _, op := range ops {
+		if el, ok := op.(rlwe.Element); ok {
+			if ct := el.AsCiphertext(); ct.MetaData != nil && ct.IsNTT != NTTFlag {
+				if NTTFlag {
+					return fmt.Errorf("expected NTT-domain ciphertext, got coefficient domain")
+				}
+				return fmt.Errorf("expected coefficient-domain ciphertext, got NTT domain")
+			}
+		}
+	}
+	return
+}
+
 // MatchScalesForMul updates the scale of either op0 or op1 if op0.Level() > op1.Level() or op1.Level() > op0.Level()
 // respectively, such taht Rescale(Mul(op0, op1)).Scale = targetScale.
 // If op0.Level() == op1.Scale() nothing is done.
@@ -58,8 +77,18 @@ func (eval *Evaluator) MatchScalesForMul(op0, op1 *rlwe.Ciphertext, targetScale
 //   - [he.Float], [he.Complex] or [he.Integer]
 //   - []complex128, []float64, []big.Float or []bignum.Complex of size at most params.MaxSlots()
 //
+// If op1 is an [rlwe.Element] (e.g. a precomputed *[rlwe.Plaintext]), it is added as-is,
+// without re-encoding: when adding a fixed vector many times (e.g. a bias across a batch),
+// encode it once into a plaintext instead of re-passing the raw vector, which is re-encoded
+// at every call (see the Evaluator/Add/Vector and Evaluator/Add/Plaintext benchmarks for the
+// difference). The plaintext's scale must match op0.Scale for the operand to be used
+// unchanged, otherwise the usual scale alignment applies.
+//
 // Passing an invalid type will return an error.
 func (eval *Evaluator) Add(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.checkNTTDomain(op0, op1); err != nil {
+		return fmt.Errorf("cannot Add: %w", err)
+	}
 	return eval.addition(op0, op1, opOut, true)
 }
 
@@ -75,6 +104,40 @@ func (eval *Evaluator) AddNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (opOut *rl
 	return opOut, eval.Add(op0, op1, opOut)
 }
 
+// AddExact adds op1 to op0 and returns the result in opOut, ensuring that the addition is
+// exact when the scales of the two operands do not match. [Evaluator.Add] aligns mismatched
+// scales by multiplying one operand by the rounded scale ratio, which injects an error
+// proportional to the fractional part of the ratio when the latter is not an integer (see
+// the basics example). In that case AddExact instead brings the operand with the smallest
+// scale to the scale of the other one with [Evaluator.SetScale], consuming one level, so
+// that no approximation error is introduced. Users can opt into this method when exactness
+// matters more than the saved level; op0 and op1 are not modified.
+func (eval *Evaluator) AddExact(op0, op1, opOut *rlwe.Ciphertext) (err error) {
+
+	if cmp := op0.Scale.Cmp(op1.Scale); cmp != 0 {
+
+		small, large := op0, op1
+		if cmp > 0 {
+			small, large = op1, op0
+		}
+
+		if ratio := new(big.Float).Quo(&large.Scale.Value, &small.Scale.Value); !ratio.IsInt() {
+			small = small.Clone()
+			if err = eval.SetScale(small, large.Scale); err != nil {
+				return fmt.Errorf("cannot AddExact: %w", err)
+			}
+		}
+
+		if err = eval.Add(small, large, opOut); err != nil {
+			return fmt.Errorf("cannot AddExact: %w", err)
+		}
+
+		return
+	}
+
+	return eval.Add(op0, op1, opOut)
+}
+
 // Sub subtracts op1 from op0 and returns the result in opOut.
 // The following types are accepted for op1:
 //   - rlwe.Element
@@ -444,12 +507,16 @@ func (eval *Evaluator) Rescale(op0, opOut *rlwe.Ciphertext) (err error) {
 		return fmt.Errorf("cannot Rescale: op0.MetaData or opOut.MetaData is nil")
 	}
 
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot Rescale: %w", err)
+	}
+
 	params := eval.Parameters()
 
 	nbRescales := params.LevelsConsumedPerRescaling()
 
 	if op0.Level() <= nbRescales-1 {
-		return fmt.Errorf("cannot Rescale: input Ciphertext level is too low")
+		return fmt.Errorf("cannot Rescale: %w: input Ciphertext level is too low", rlwe.ErrInsufficientLevel)
 	}
 
 	eval.divroundbylastmoduli(nbRescales, op0, opOut)
@@ -480,7 +547,7 @@ func (eval *Evaluator) RescaleTo(op0 *rlwe.Ciphertext, minScale rlwe.Scale, opOu
 	}
 
 	if op0.Level() == 0 {
-		return fmt.Errorf("cannot RescaleTo: input Ciphertext already at level 0")
+		return fmt.Errorf("cannot RescaleTo: %w: input Ciphertext already at level 0", rlwe.ErrInsufficientLevel)
 	}
 
 	*opOut.MetaData = *op0.MetaData
@@ -536,6 +603,46 @@ func (eval *Evaluator) divroundbylastmoduli(nbRescales int, op0, op1 *rlwe.Ciphe
 	}
 }
 
+// RescaleThenAdd rescales op0 and adds op1 to the result, returning it in opOut.
+// It is equivalent to calling [Evaluator.Rescale] followed by [Evaluator.Add], except that
+// if op1 is an [rlwe.Element] whose scale matches the post-rescale scale of op0, the
+// operands are added directly, skipping the scale-matching pass of [Evaluator.Add].
+// This is targeted at deep circuits evaluating opOut = Rescale(Mul(a, b)) + c with c
+// already at the lower level and scale.
+//
+// Returns an error if:
+//   - Either op0 or opOut MetaData are nil
+//   - The level of op0 is too low to enable a rescale
+func (eval *Evaluator) RescaleThenAdd(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
+
+	if err = eval.Rescale(op0, opOut); err != nil {
+		return fmt.Errorf("eval.Rescale: %w", err)
+	}
+
+	if el, isElement := op1.(rlwe.Element); isElement {
+
+		ct := el.AsCiphertext()
+
+		if ct.MetaData != nil && opOut.Scale.Cmp(ct.Scale) == 0 {
+
+			// Checks operand validity and retrieves minimum level
+			degree, level, err := eval.InitOutputBinaryOp(opOut, ct, opOut.Degree()+ct.Degree(), opOut)
+			if err != nil {
+				return fmt.Errorf("eval.InitOutputBinaryOp: %w", err)
+			}
+
+			opOut.ResizeQ(level)
+			opOut.ResizeDegree(degree)
+
+			eval.evaluateInPlace(level, opOut, ct, opOut, eval.Parameters().RingQ().AtLevel(level).Add)
+
+			return nil
+		}
+	}
+
+	return eval.Add(opOut, op1, opOut)
+}
+
 // MulNew multiplies op0 with op1 without relinearization and returns the result in a newly created element opOut.
 //
 // op1.(type) can be
@@ -563,6 +670,11 @@ func (eval *Evaluator) MulNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (opOut *rl
 //   - The procedure will return an error if either op0 or op1 are have a degree higher than 1.
 //   - The procedure will return an error if opOut.Degree != op0.Degree + op1.Degree.
 func (eval *Evaluator) Mul(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
+
+	if err = eval.checkNTTDomain(op0, op1); err != nil {
+		return fmt.Errorf("cannot Mul: %w", err)
+	}
+
 	switch op1 := op1.(type) {
 	case rlwe.Element:
 
@@ -724,6 +836,108 @@ func (eval *Evaluator) MulRelin(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut *r
 	return
 }
 
+// Square computes the square of op0 and returns the result in opOut, after relinearization.
+// It is a shorthand for MulRelin(op0, op0, opOut) guaranteeing the dedicated squaring code
+// path of the tensoring, which spares one of the base multiplications of a generic product.
+// The scale of opOut is op0.Scale^2.
+func (eval *Evaluator) Square(op0, opOut *rlwe.Ciphertext) (err error) {
+	return eval.MulRelin(op0, op0, opOut)
+}
+
+// SquareNew computes the square of op0 and returns the result in a newly created element
+// opOut, after relinearization. See [Evaluator.Square].
+func (eval *Evaluator) SquareNew(op0 *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+	return eval.MulRelinNew(op0, op0)
+}
+
+// SquareThenAdd computes the square of op0 and adds the result to opOut, after
+// relinearization, through the dedicated squaring code path of the tensoring.
+// It is useful for sum-of-squares circuits. See [Evaluator.MulRelinThenAdd] for
+// the requirements on the operands.
+func (eval *Evaluator) SquareThenAdd(op0, opOut *rlwe.Ciphertext) (err error) {
+	return eval.MulRelinThenAdd(op0, op0, opOut)
+}
+
+// Mask zeroes all the slots of op0 outside of the range [from, to) and writes the result on opOut.
+// The 0/1 mask is encoded at the scale of op0 and multiplied without relinearization (plaintext
+// product), thus no level is consumed by the method: the scale of opOut is op0.Scale^2 with one
+// pending rescaling, like after a product between two ciphertexts, letting the caller defer the
+// rescaling to where it would happen anyway in the circuit.
+func (eval *Evaluator) Mask(op0 *rlwe.Ciphertext, from, to int, opOut *rlwe.Ciphertext) (err error) {
+
+	slots := eval.Parameters().MaxSlots()
+
+	if from < 0 || to < from || to > slots {
+		return fmt.Errorf("cannot Mask: invalid range [%d, %d): must satisfy 0 <= from <= to <= %d", from, to, slots)
+	}
+
+	_, level, err := eval.InitOutputUnaryOp(op0, opOut)
+	if err != nil {
+		return fmt.Errorf("cannot Mask: %w", err)
+	}
+
+	opOut.ResizeQ(level)
+	opOut.ResizeDegree(op0.Degree())
+
+	// Instantiates new plaintext from buffer
+	pt, err := rlwe.NewPlaintextAtLevelFromPoly(level, -1, eval.BuffQ[0], ring.RNSPoly{})
+
+	// Sanity check, this error should not happen unless the evaluator's buffers
+	// were improperly tempered with.
+	if err != nil {
+		panic(err)
+	}
+
+	*pt.MetaData = *op0.MetaData
+	pt.Scale = op0.Scale
+
+	mask := make([]float64, slots)
+	for i := from; i < to; i++ {
+		mask[i] = 1
+	}
+
+	// Encodes the mask on the plaintext
+	if err = eval.Encoder.Encode(mask, pt); err != nil {
+		return fmt.Errorf("cannot Mask: %w", err)
+	}
+
+	// Generic in place evaluation
+	if err = eval.mulRelin(op0, pt.AsCiphertext(), false, opOut); err != nil {
+		return fmt.Errorf("cannot Mask: %w", err)
+	}
+
+	return
+}
+
+// DivScalarEpsilon is the threshold on the magnitude of a scalar divisor below which
+// [Evaluator.DivScalarNew] returns an error instead of multiplying by an inaccurately
+// large reciprocal. It can be tuned by the user.
+var DivScalarEpsilon = 0x1p-53
+
+// DivScalarNew divides op0 by the scalar and returns the result in a newly created element opOut.
+// The reciprocal of the scalar is computed at the encoding precision of the parameters before
+// being multiplied with op0, avoiding the precision loss of a reciprocal computed in complex128.
+// The procedure will return an error if the magnitude of the scalar is smaller or equal to
+// [DivScalarEpsilon].
+func (eval *Evaluator) DivScalarNew(op0 *rlwe.Ciphertext, scalar complex128) (opOut *rlwe.Ciphertext, err error) {
+
+	if cmplx.Abs(scalar) <= DivScalarEpsilon {
+		return nil, fmt.Errorf("cannot DivScalarNew: |scalar|=%e <= DivScalarEpsilon=%e", cmplx.Abs(scalar), DivScalarEpsilon)
+	}
+
+	prec := eval.Parameters().EncodingPrecision()
+
+	// inv = conj(scalar) / |scalar|^2
+	inv := bignum.ToComplex(scalar, prec)
+	norm := new(big.Float).SetPrec(prec).Mul(&inv[0], &inv[0])
+	norm.Add(norm, new(big.Float).SetPrec(prec).Mul(&inv[1], &inv[1]))
+	inv[0].Quo(&inv[0], norm)
+	inv[1].Quo(&inv[1], norm)
+	inv[1].Neg(&inv[1])
+
+	return eval.MulNew(op0, inv)
+}
+
 func (eval *Evaluator) tensorStandardDegreeTwo(LevelQ int, op0, op1, op2 *rlwe.Ciphertext, c2 ring.RNSPoly) {
 
 	rQ := eval.parameters.RingQ().AtLevel(LevelQ)
@@ -915,7 +1129,7 @@ func (eval *Evaluator) MulThenAdd(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut
 		} else if cmp == -1 { // opOut.Scale > op0.Scale then the scaling factor for op1 becomes the quotient between the two scales
 			scaleRLWE = opOut.Scale.Div(op0.Scale)
 		} else {
-			return fmt.Errorf("cannot MulThenAdd: op0.Scale > opOut.Scale is not supported")
+			return fmt.Errorf("cannot MulThenAdd: %w: op0.Scale > opOut.Scale is not supported", rlwe.ErrScaleMismatch)
 		}
 
 		RNSReal, RNSImag := bigComplexToRNSScalar(rQ, &scaleRLWE.Value, cmplxBig)
@@ -954,7 +1168,7 @@ func (eval *Evaluator) MulThenAdd(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut
 		} else if cmp == -1 { // opOut.Scale > op0.Scale then the scaling factor for op1 becomes the quotient between the two scales
 			scaleRLWE = opOut.Scale.Div(op0.Scale)
 		} else {
-			return fmt.Errorf("cannot MulThenAdd: op0.Scale > opOut.Scale is not supported")
+			return fmt.Errorf("cannot MulThenAdd: %w: op0.Scale > opOut.Scale is not supported", rlwe.ErrScaleMismatch)
 		}
 
 		// Instantiates new plaintext from buffer
@@ -984,6 +1198,26 @@ func (eval *Evaluator) MulThenAdd(op0 *rlwe.Ciphertext, op1 rlwe.Operand, opOut
 	return
 }
 
+// MulScalarThenAdd evaluates opOut = opOut + op0 * scalar without intermediate ciphertext,
+// the scalar multiply-accumulate being carried out by the fused ring primitive
+// [ring.RNSRing.MulDoubleRNSScalarThenAdd].
+//
+// The following types are accepted for scalar: [he.Complex], [he.Float], [he.Int].
+// Passing any other type will return an error.
+//
+// The scale handling is identical to [Evaluator.MulThenAdd] with a scalar operand: if
+// op0.Scale == opOut.Scale and scalar is not a Gaussian integer, then opOut is multiplied
+// by Q[min(op0.Level(), opOut.Level())] and the scalar is scaled accordingly, ensuring a
+// noiseless addition. See [Evaluator.MulThenAdd] for the details.
+func (eval *Evaluator) MulScalarThenAdd(op0 *rlwe.Ciphertext, scalar rlwe.Operand, opOut *rlwe.Ciphertext) (err error) {
+	switch scalar.(type) {
+	case complex128, float64, int, int64, uint, uint64, *big.Int, *big.Float, *bignum.Complex, big.Int, big.Float, bignum.Complex:
+		return eval.MulThenAdd(op0, scalar, opOut)
+	default:
+		return fmt.Errorf("cannot MulScalarThenAdd: scalar must be he.Complex, he.Float or he.Int, but is %T", scalar)
+	}
+}
+
 // MulRelinThenAdd multiplies op0 with op1 with relinearization and adds the result on opOut.
 //
 // The following types are accepted for op1:
@@ -1135,6 +1369,9 @@ func (eval *Evaluator) RotateNew(op0 *rlwe.Ciphertext, k int) (opOut *rlwe.Ciphe
 // Rotate rotates the columns of op0 by k positions to the left and returns the result in opOut.
 // The method will return an error if the evaluator hasn't been given an evaluation key set with the appropriate GaloisKey.
 func (eval *Evaluator) Rotate(op0 *rlwe.Ciphertext, k int, opOut *rlwe.Ciphertext) (err error) {
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot Rotate: %w", err)
+	}
 	if err = eval.Automorphism(op0, eval.Parameters().GaloisElement(k), opOut); err != nil {
 		return fmt.Errorf("eval.Automorphism: %w", err)
 	}
@@ -1148,12 +1385,23 @@ func (eval *Evaluator) ConjugateNew(op0 *rlwe.Ciphertext) (opOut *rlwe.Ciphertex
 	return opOut, eval.Conjugate(op0, opOut)
 }
 
+// SupportsConjugation returns whether the Evaluator supports the Conjugate operation, i.e.
+// whether parameters.RingType() == ring.Standard. The ConjugateInvariant ring packs real
+// values only, which are their own conjugate, hence the operation is not defined over it.
+func (eval *Evaluator) SupportsConjugation() bool {
+	return eval.Parameters().RingType() == ring.Standard
+}
+
 // Conjugate conjugates op0 (which is equivalent to a row rotation) and returns the result in opOut.
 // The method will return an error if the evaluator hasn't been given an evaluation key set with the appropriate GaloisKey.
 func (eval *Evaluator) Conjugate(op0 *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (err error) {
 
-	if eval.Parameters().RingType() == ring.ConjugateInvariant {
-		return fmt.Errorf("method is not supported when parameters.RingType() == ring.ConjugateInvariant")
+	if !eval.SupportsConjugation() {
+		return fmt.Errorf("method is not supported when parameters.RingType() == ring.ConjugateInvariant: values are packed in the real slots only, which are invariant under conjugation")
+	}
+
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot Conjugate: %w", err)
 	}
 
 	if err = eval.Automorphism(op0, eval.Parameters().GaloisElementOrderTwoOrthogonalSubgroup(), opOut); err != nil {
@@ -1163,6 +1411,37 @@ func (eval *Evaluator) Conjugate(op0 *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (
 	return
 }
 
+// ConjugateHoistedNew conjugates ctIn using the provided pre-filled [rlwe.HoistingBuffer] and returns
+// the result in a newly created element. See [Evaluator.ConjugateHoisted].
+func (eval *Evaluator) ConjugateHoistedNew(ctIn *rlwe.Ciphertext, buf rlwe.HoistingBuffer) (opOut *rlwe.Ciphertext, err error) {
+	opOut = NewCiphertext(eval.Parameters(), 1, ctIn.Level())
+	return opOut, eval.ConjugateHoisted(ctIn, buf, opOut)
+}
+
+// ConjugateHoisted conjugates ctIn and returns the result in opOut, consuming the pre-filled
+// [rlwe.HoistingBuffer] produced by [rlwe.Evaluator.FillHoistingBuffer] instead of decomposing
+// ctIn again. Since the conjugation is an automorphism, its key-switch can share the
+// decomposition of ctIn with other hoisted automorphisms, e.g. [Evaluator.RotateHoisted]
+// (which fills the buffer itself) followed by ConjugateHoisted on the same ciphertext.
+// The method will return an error if the evaluator hasn't been given an evaluation key set
+// with the appropriate GaloisKey.
+func (eval *Evaluator) ConjugateHoisted(ctIn *rlwe.Ciphertext, buf rlwe.HoistingBuffer, opOut *rlwe.Ciphertext) (err error) {
+
+	if !eval.SupportsConjugation() {
+		return fmt.Errorf("method is not supported when parameters.RingType() == ring.ConjugateInvariant: values are packed in the real slots only, which are invariant under conjugation")
+	}
+
+	if err = eval.checkNTTDomain(ctIn); err != nil {
+		return fmt.Errorf("cannot ConjugateHoisted: %w", err)
+	}
+
+	if err = eval.AutomorphismHoisted(ctIn, buf, eval.Parameters().GaloisElementOrderTwoOrthogonalSubgroup(), opOut); err != nil {
+		return fmt.Errorf("eval.AutomorphismHoisted: %w", err)
+	}
+
+	return
+}
+
 // RotateHoistedNew takes an input Ciphertext and a list of rotations and returns a map of Ciphertext, where each element of the map is the input Ciphertext
 // rotation by one element of the list. It is much faster than sequential calls to Rotate.
 func (eval *Evaluator) RotateHoistedNew(ctIn *rlwe.Ciphertext, rotations []int, buf rlwe.HoistingBuffer) (opOut map[int]*rlwe.Ciphertext, err error) {
@@ -1179,15 +1458,56 @@ func (eval *Evaluator) RotateHoistedNew(ctIn *rlwe.Ciphertext, rotations []int,
 // It is much faster than sequential calls to Rotate.
 func (eval *Evaluator) RotateHoisted(ctIn *rlwe.Ciphertext, rotations []int, buf rlwe.HoistingBuffer, opOut map[int]*rlwe.Ciphertext) (err error) {
 	eval.FillHoistingBuffer(ctIn.Level(), eval.Parameters().MaxLevelP(), ctIn.Q[1], ctIn.IsNTT, buf)
-	for _, i := range rotations {
-		if err = eval.AutomorphismHoisted(ctIn, buf, eval.Parameters().GaloisElement(i), opOut[i]); err != nil {
-			return fmt.Errorf("cannot RotateHoisted: %w", err)
-		}
+
+	galEls := make([]uint64, len(rotations))
+	cts := make(map[uint64]*rlwe.Ciphertext, len(rotations))
+	for i, k := range rotations {
+		galEls[i] = eval.Parameters().GaloisElement(k)
+		cts[galEls[i]] = opOut[k]
+	}
+
+	if err = eval.AutomorphismMany(ctIn, buf, galEls, cts); err != nil {
+		return fmt.Errorf("cannot RotateHoisted: %w", err)
 	}
 
 	return
 }
 
+// RotateRange generates all the rotations of ctIn by k for k in the arithmetic range from, from+step, ...
+// up to but excluding to, using a single hoisted decomposition of ctIn (see [Evaluator.RotateHoisted]).
+// Negative steps are supported, in which case from must be larger than to.
+// The returned map is indexed by the rotation k.
+//
+// Rotation convention: a positive k rotates the columns by k positions to the left, i.e. slot i of
+// the output holds slot (i+k)%slots of the input, and a negative k rotates the columns by |k|
+// positions to the right.
+//
+// The method will return an error if step is zero, if the range is empty or if the evaluator hasn't
+// been given an evaluation key set with the appropriate GaloisKeys.
+func (eval *Evaluator) RotateRange(ctIn *rlwe.Ciphertext, from, to, step int, buf rlwe.HoistingBuffer) (opOut map[int]*rlwe.Ciphertext, err error) {
+
+	if step == 0 {
+		return nil, fmt.Errorf("cannot RotateRange: step cannot be zero")
+	}
+
+	if (step > 0 && from >= to) || (step < 0 && from <= to) {
+		return nil, fmt.Errorf("cannot RotateRange: empty range: from=%d to=%d step=%d", from, to, step)
+	}
+
+	var rotations []int
+	if step > 0 {
+		for k := from; k < to; k += step {
+			rotations = append(rotations, k)
+		}
+	} else {
+		for k := from; k > to; k += step {
+			rotations = append(rotations, k)
+		}
+	}
+
+	return eval.RotateHoistedNew(ctIn, rotations, buf)
+}
+
 func (eval *Evaluator) RotateHoistedLazyNew(level int, rotations []int, ct *rlwe.Ciphertext, buf rlwe.HoistingBuffer) (cOut map[int]*rlwe.Ciphertext, err error) {
 	cOut = make(map[int]*rlwe.Ciphertext)
 	for _, i := range rotations {
@@ -3,9 +3,13 @@ package hefloat_test
 import (
 	"testing"
 
+	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils"
 	"github.com/Pro7ech/lattigo/utils/bignum"
+	"github.com/Pro7ech/lattigo/utils/sampling"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,6 +58,29 @@ func testEvaluatorAdd(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(tc.params, "Evaluator/Add/Pt/Precomputed"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		v1, plaintext2, _ := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		ptCopy := plaintext2.Clone()
+
+		// A precomputed plaintext is reused as-is across additions, without re-encoding
+		for range 2 {
+			require.NoError(t, tc.evaluator.Add(ct0, plaintext2, ct0))
+		}
+
+		for i := range v0 {
+			v0[i].Add(&v0[i], &v1[i])
+			v0[i].Add(&v0[i], &v1[i])
+		}
+
+		// The additions must leave the plaintext untouched
+		require.Equal(t, ptCopy.Q, plaintext2.Q)
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
 	t.Run(GetTestName(tc.params, "Evaluator/Add/Scalar"), func(t *testing.T) {
 
 		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
@@ -82,6 +109,60 @@ func testEvaluatorAdd(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/AddExact/Ct"), func(t *testing.T) {
+
+		if tc.params.MaxLevel() < tc.params.LevelsConsumedPerRescaling() {
+			t.Skip("MaxLevel() too low")
+		}
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		v1, pt1, _ := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		// Re-encrypts the second operand at 1.5 times the default scale: the
+		// scale ratio is not an integer, making the scale matching of Add inexact.
+		pt1.Scale = pt1.Scale.Mul(rlwe.NewScale(1.5))
+		require.NoError(t, tc.encoder.Encode(v1, pt1))
+		ct1 := hefloat.NewCiphertext(tc.params, 1, pt1.Level())
+		require.NoError(t, tc.encryptorSk.Encrypt(pt1, ct1))
+
+		want := make([]bignum.Complex, len(v0))
+		for i := range want {
+			want[i].SetPrec(tc.encoder.Prec())
+			want[i].Add(&v0[i], &v1[i])
+		}
+
+		resAdd, err := tc.evaluator.AddNew(ct0, ct1)
+		require.NoError(t, err)
+
+		resExact := hefloat.NewCiphertext(tc.params, 1, ct0.Level())
+		require.NoError(t, tc.evaluator.AddExact(ct0, ct1, resExact))
+
+		// One level is consumed to equalize the scales
+		require.Equal(t, ct0.Level()-tc.params.LevelsConsumedPerRescaling(), resExact.Level())
+
+		// The exact addition does not suffer from the fractional-ratio error
+		statsAdd := hefloat.GetPrecisionStats(tc.params, tc.encoder, tc.decryptor, want, resAdd, 0, false)
+		statsExact := hefloat.GetPrecisionStats(tc.params, tc.encoder, tc.decryptor, want, resExact, 0, false)
+		require.Greater(t, statsExact.AvgPrec.L2, statsAdd.AvgPrec.L2)
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, resExact, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/NTTDomain"), func(t *testing.T) {
+
+		_, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		_, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		// Operands in the wrong NTT domain are rejected with an explicit error
+		ct0.IsNTT = !ct0.IsNTT
+
+		require.Error(t, tc.evaluator.Add(ct0, ct1, ct0))
+		require.Error(t, tc.evaluator.Add(ct1, ct0, ct1))
+		require.Error(t, tc.evaluator.Mul(ct0, ct1, ct0))
+		require.Error(t, tc.evaluator.Rescale(ct0, ct0))
+		require.Error(t, tc.evaluator.Rotate(ct0, 1, ct0))
+	})
 }
 
 func testEvaluatorSub(tc *testContext, t *testing.T) {
@@ -183,6 +264,15 @@ func testEvaluatorRescale(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(tc.params, "Evaluator/Rescale/InsufficientLevel"), func(t *testing.T) {
+
+		_, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		tc.evaluator.DropLevel(ct0, ct0.Level())
+
+		require.ErrorIs(t, tc.evaluator.Rescale(ct0, ct0), rlwe.ErrInsufficientLevel)
+	})
+
 	t.Run(GetTestName(tc.params, "Evaluator/RescaleTo/Many"), func(t *testing.T) {
 
 		if tc.params.MaxLevel() < 2 {
@@ -208,6 +298,51 @@ func testEvaluatorRescale(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/RescaleThenAdd"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		mul := bignum.NewComplexMultiplier()
+
+		for i := range v0 {
+			mul.Mul(&v0[i], &v0[i], &v0[i])
+		}
+
+		require.NoError(t, tc.evaluator.MulRelin(ct0, ct0, ct0))
+
+		// Computes the post-rescale scale of ct0
+		nbRescales := tc.params.LevelsConsumedPerRescaling()
+		scale := ct0.Scale
+		for i := 0; i < nbRescales; i++ {
+			scale = scale.Div(rlwe.NewScale(tc.ringQ[ct0.Level()-i].Modulus))
+		}
+
+		// Encrypts the addend directly at the post-rescale level and scale of ct0
+		v1 := make([]bignum.Complex, len(v0))
+		r := sampling.NewSource([32]byte{})
+		for i := range v1 {
+			v1[i].SetPrec(tc.encoder.Prec())
+			v1[i][0].SetFloat64(r.Float64(-1, 1))
+			if tc.params.RingType() == ring.Standard {
+				v1[i][1].SetFloat64(r.Float64(-1, 1))
+			}
+			v0[i].Add(&v0[i], &v1[i])
+		}
+
+		pt1 := hefloat.NewPlaintext(tc.params, ct0.Level()-nbRescales)
+		pt1.Scale = scale
+		require.NoError(t, tc.encoder.Encode(v1, pt1))
+		ct1 := hefloat.NewCiphertext(tc.params, 1, pt1.Level())
+		require.NoError(t, tc.encryptorSk.Encrypt(pt1, ct1))
+
+		require.NoError(t, tc.evaluator.RescaleThenAdd(ct0, ct1, ct0))
+
+		require.Equal(t, ct1.Level(), ct0.Level())
+		require.True(t, ct0.Scale.Equal(ct1.Scale))
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
 }
 
 func testEvaluatorMul(tc *testContext, t *testing.T) {
@@ -245,6 +380,33 @@ func testEvaluatorMul(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(tc.params, "Evaluator/DivScalarNew/Ct/Scalar"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		scalar := 3.1415 - 1.4142i
+		if tc.params.RingType() == ring.ConjugateInvariant {
+			scalar = complex(real(scalar), 0)
+		}
+
+		mul := bignum.NewComplexMultiplier()
+
+		sBig := bignum.ToComplex(scalar, tc.encoder.Prec())
+
+		for i := range v0 {
+			mul.Quo(&v0[i], sBig, &v0[i])
+		}
+
+		res, err := tc.evaluator.DivScalarNew(ct0, scalar)
+		require.NoError(t, err)
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, res, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Division by a scalar too close to zero must error
+		_, err = tc.evaluator.DivScalarNew(ct0, 0)
+		require.Error(t, err)
+	})
+
 	t.Run(GetTestName(tc.params, "Evaluator/Mul/Ct/Vector"), func(t *testing.T) {
 
 		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
@@ -419,6 +581,30 @@ func testEvaluatorMulThenAdd(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v1, ct1, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(tc.params, "Evaluator/MulScalarThenAdd"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		v1, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		constant := randomConst(tc.params.RingType(), tc.encoder.Prec(), -1+1i, -1+1i)
+
+		mul := bignum.NewComplexMultiplier()
+
+		tmp := new(bignum.Complex)
+
+		for i := range v0 {
+			mul.Mul(&v0[i], constant, tmp)
+			v1[i].Add(&v1[i], tmp)
+		}
+
+		require.NoError(t, tc.evaluator.MulScalarThenAdd(ct0, constant, ct1))
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v1, ct1, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Non-scalar operands are rejected
+		require.Error(t, tc.evaluator.MulScalarThenAdd(ct0, []complex128{1}, ct1))
+	})
+
 	t.Run(GetTestName(tc.params, "Evaluator/MulThenAdd/Vector"), func(t *testing.T) {
 
 		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
@@ -503,4 +689,255 @@ func testEvaluatorMulThenAdd(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/Square/Ct"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		mul := bignum.NewComplexMultiplier()
+
+		want := make([]bignum.Complex, len(v0))
+		for i := range want {
+			want[i].SetPrec(tc.encoder.Prec())
+			mul.Mul(&v0[i], &v0[i], &want[i])
+		}
+
+		res, err := tc.evaluator.SquareNew(ct0)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Degree())
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, res, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// In-place squaring
+		require.NoError(t, tc.evaluator.Square(ct0, ct0))
+		require.Equal(t, 1, ct0.Degree())
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// res = res + op0^2 (the sampling of the test vectors is deterministic,
+		// so ct1 encrypts the same values as ct0)
+		_, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		require.NoError(t, tc.evaluator.SquareThenAdd(ct1, res))
+		require.Equal(t, 1, res.Degree())
+
+		for i := range want {
+			want[i].Add(&want[i], &want[i])
+		}
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, res, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/Mask/Ct"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		from, to := len(v0)>>2, len(v0)>>1
+
+		want := make([]bignum.Complex, len(v0))
+		for i := from; i < to; i++ {
+			want[i].SetPrec(tc.encoder.Prec()).Set(&v0[i])
+		}
+
+		res := hefloat.NewCiphertext(tc.params, 1, ct0.Level())
+		require.NoError(t, tc.evaluator.Mask(ct0, from, to, res))
+
+		// No level is consumed, the scale is squared with one pending rescaling
+		require.Equal(t, ct0.Level(), res.Level())
+		require.Equal(t, 0, res.Scale.Cmp(ct0.Scale.Mul(ct0.Scale)))
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, res, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// The range must satisfy 0 <= from <= to <= slots
+		require.Error(t, tc.evaluator.Mask(ct0, -1, to, res))
+		require.Error(t, tc.evaluator.Mask(ct0, to, from, res))
+		require.Error(t, tc.evaluator.Mask(ct0, from, tc.params.MaxSlots()+1, res))
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/RelinAccumulator"), func(t *testing.T) {
+
+		acc := he.NewRelinAccumulator(tc.evaluator)
+
+		res := hefloat.NewCiphertext(tc.params, 1, tc.params.MaxLevel())
+
+		// Finalizing an empty accumulator must fail
+		require.Error(t, acc.Finalize(res))
+
+		mul := bignum.NewComplexMultiplier()
+
+		want := make([]bignum.Complex, tc.params.MaxSlots())
+		for i := range want {
+			want[i].SetPrec(tc.encoder.Prec())
+		}
+
+		var tmp bignum.Complex
+		tmp.SetPrec(tc.encoder.Prec())
+
+		// Inner product between two vectors of three ciphertexts,
+		// with a single relinearization at the end
+		for k := range 3 {
+
+			a := complex(float64(int(1)<<k), 0)
+
+			v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1/a-1i/a, 1/a+1i/a, t)
+			v1, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1/a-1i/a, 1/a+1i/a, t)
+
+			require.NoError(t, acc.MulThenAdd(ct0, ct1))
+
+			for i := range want {
+				mul.Mul(&v0[i], &v1[i], &tmp)
+				want[i].Add(&want[i], &tmp)
+			}
+		}
+
+		require.NoError(t, acc.Finalize(res))
+		require.Equal(t, 1, res.Degree())
+
+		hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, want, res, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// The accumulator is reset by Finalize
+		require.Error(t, acc.Finalize(res))
+	})
+}
+
+func testEvaluatorConjugate(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/Conjugate"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		if tc.params.RingType() == ring.ConjugateInvariant {
+
+			require.False(t, tc.evaluator.SupportsConjugation())
+
+			err := tc.evaluator.Conjugate(ct0, ct0)
+			require.Error(t, err)
+			require.ErrorContains(t, err, "ConjugateInvariant")
+
+		} else {
+
+			require.True(t, tc.evaluator.SupportsConjugation())
+
+			for i := range v0 {
+				v0[i][1].Neg(&v0[i][1])
+			}
+
+			galEl := tc.params.GaloisElementForComplexConjugation()
+			eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew([]uint64{galEl}, tc.sk)...))
+
+			require.NoError(t, eval.Conjugate(ct0, ct0))
+
+			hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ct0, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+
+	t.Run(GetTestName(tc.params, "Evaluator/ConjugateHoisted"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		buf := tc.evaluator.NewHoistingBuffer(ct0.LevelQ(), tc.params.MaxLevelP())
+
+		if tc.params.RingType() == ring.ConjugateInvariant {
+
+			err := tc.evaluator.ConjugateHoisted(ct0, buf, ct0)
+			require.Error(t, err)
+			require.ErrorContains(t, err, "ConjugateInvariant")
+
+		} else {
+
+			k := 1
+
+			galEls := []uint64{tc.params.GaloisElement(k), tc.params.GaloisElementForComplexConjugation()}
+			eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...))
+
+			// The conjugation shares the decomposition of ct0 filled by RotateHoisted
+			cts, err := eval.RotateHoistedNew(ct0, []int{k}, buf)
+			require.NoError(t, err)
+
+			ctConj, err := eval.ConjugateHoistedNew(ct0, buf)
+			require.NoError(t, err)
+
+			vRot := utils.RotateSlice(v0, k)
+
+			for i := range v0 {
+				v0[i][1].Neg(&v0[i][1])
+			}
+
+			hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, vRot, cts[k], tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+			hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, v0, ctConj, tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+	})
+}
+
+func testEvaluatorRotateRange(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/RotateRange"), func(t *testing.T) {
+
+		v0, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		from, to, step := -4, 6, 2
+
+		rotations := []int{-4, -2, 0, 2, 4}
+
+		galEls := make([]uint64, len(rotations))
+		for i, k := range rotations {
+			galEls[i] = tc.params.GaloisElement(k)
+		}
+
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...))
+
+		buf := eval.NewHoistingBuffer(ct0.LevelQ(), tc.params.MaxLevelP())
+
+		cts, err := eval.RotateRange(ct0, from, to, step, buf)
+		require.NoError(t, err)
+		require.Equal(t, len(rotations), len(cts))
+
+		for _, k := range rotations {
+			// A positive k rotates the columns by k positions to the left
+			hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, utils.RotateSlice(v0, k), cts[k], tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+
+		// Negative steps enumerate the same range backward
+		cts, err = eval.RotateRange(ct0, 4, -6, -2, buf)
+		require.NoError(t, err)
+		require.Equal(t, len(rotations), len(cts))
+
+		for _, k := range rotations {
+			hefloat.VerifyTestVectors(tc.params, tc.encoder, tc.decryptor, utils.RotateSlice(v0, k), cts[k], tc.params.LogDefaultScale(), 0, *printPrecisionStats, t)
+		}
+
+		// Zero step and empty ranges must error
+		_, err = eval.RotateRange(ct0, 0, 4, 0, buf)
+		require.Error(t, err)
+		_, err = eval.RotateRange(ct0, 4, 0, 1, buf)
+		require.Error(t, err)
+		_, err = eval.RotateRange(ct0, 0, 4, -1, buf)
+		require.Error(t, err)
+	})
+}
+
+func testEvaluatorTracked(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "Evaluator/Tracked"), func(t *testing.T) {
+
+		rlwe.ScaleTracking = true
+		defer func() { rlwe.ScaleTracking = false }()
+
+		_, _, ct0 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+		_, _, ct1 := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		t0 := rlwe.NewTrackedCiphertext(ct0)
+		t1 := rlwe.NewTrackedCiphertext(ct1)
+		out := rlwe.NewTrackedCiphertext(hefloat.NewCiphertext(tc.params, 1, ct0.Level()))
+
+		require.NoError(t, tc.evaluator.MulRelinTracked(t0, t1, out))
+		require.NoError(t, tc.evaluator.RescaleTracked(out, out))
+		require.NoError(t, tc.evaluator.AddTracked(out, out, out))
+
+		// A manual modification of the actual scale must trip the assertion.
+		out.Scale = out.Scale.Mul(rlwe.NewScale(2))
+		require.Panics(t, func() {
+			_ = tc.evaluator.AddTracked(out, out, out)
+		})
+	})
 }
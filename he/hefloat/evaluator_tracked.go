@@ -0,0 +1,107 @@
+package hefloat
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// AddTracked adds op1 to op0 and returns the result in opOut, updating the intended
+// scale of opOut to the maximum of the two intended scales (addition requires the
+// scales to match and the evaluator scales the operand with the smallest scale up).
+// If [rlwe.ScaleTracking] is enabled, the method then asserts that the actual scale
+// of opOut matches its intended scale.
+func (eval *Evaluator) AddTracked(op0, op1, opOut *rlwe.TrackedCiphertext) (err error) {
+
+	if err = eval.Add(op0.Ciphertext, op1.Ciphertext, opOut.Ciphertext); err != nil {
+		return fmt.Errorf("eval.Add: %w", err)
+	}
+
+	trackedMax(&op0.TrackedScale, &op1.TrackedScale, &opOut.TrackedScale)
+	opOut.AssertScale()
+
+	return
+}
+
+// SubTracked subtracts op1 from op0 and returns the result in opOut, updating the
+// intended scale of opOut to the maximum of the two intended scales.
+// If [rlwe.ScaleTracking] is enabled, the method then asserts that the actual scale
+// of opOut matches its intended scale.
+func (eval *Evaluator) SubTracked(op0, op1, opOut *rlwe.TrackedCiphertext) (err error) {
+
+	if err = eval.Sub(op0.Ciphertext, op1.Ciphertext, opOut.Ciphertext); err != nil {
+		return fmt.Errorf("eval.Sub: %w", err)
+	}
+
+	trackedMax(&op0.TrackedScale, &op1.TrackedScale, &opOut.TrackedScale)
+	opOut.AssertScale()
+
+	return
+}
+
+// MulTracked multiplies op0 with op1 (without relinearization) and returns the result
+// in opOut, updating the intended scale of opOut to the product of the two intended
+// scales. If [rlwe.ScaleTracking] is enabled, the method then asserts that the actual
+// scale of opOut matches its intended scale.
+func (eval *Evaluator) MulTracked(op0, op1, opOut *rlwe.TrackedCiphertext) (err error) {
+
+	if err = eval.Mul(op0.Ciphertext, op1.Ciphertext, opOut.Ciphertext); err != nil {
+		return fmt.Errorf("eval.Mul: %w", err)
+	}
+
+	opOut.TrackedScale.Mul(&op0.TrackedScale, &op1.TrackedScale)
+	opOut.AssertScale()
+
+	return
+}
+
+// MulRelinTracked multiplies op0 with op1 with relinearization and returns the result
+// in opOut, updating the intended scale of opOut to the product of the two intended
+// scales. If [rlwe.ScaleTracking] is enabled, the method then asserts that the actual
+// scale of opOut matches its intended scale.
+func (eval *Evaluator) MulRelinTracked(op0, op1, opOut *rlwe.TrackedCiphertext) (err error) {
+
+	if err = eval.MulRelin(op0.Ciphertext, op1.Ciphertext, opOut.Ciphertext); err != nil {
+		return fmt.Errorf("eval.MulRelin: %w", err)
+	}
+
+	opOut.TrackedScale.Mul(&op0.TrackedScale, &op1.TrackedScale)
+	opOut.AssertScale()
+
+	return
+}
+
+// RescaleTracked rescales op0 and returns the result in opOut, dividing the intended
+// scale of opOut by the product of the moduli dropped by the rescaling.
+// If [rlwe.ScaleTracking] is enabled, the method then asserts that the actual scale
+// of opOut matches its intended scale.
+func (eval *Evaluator) RescaleTracked(op0, opOut *rlwe.TrackedCiphertext) (err error) {
+
+	levelIn := op0.Level()
+
+	rQ := eval.Parameters().RingQ()
+
+	if err = eval.Rescale(op0.Ciphertext, opOut.Ciphertext); err != nil {
+		return fmt.Errorf("eval.Rescale: %w", err)
+	}
+
+	q := new(big.Int).SetUint64(1)
+	for i := opOut.Level() + 1; i <= levelIn; i++ {
+		q.Mul(q, new(big.Int).SetUint64(rQ[i].Modulus))
+	}
+
+	opOut.TrackedScale.Quo(&op0.TrackedScale, new(big.Rat).SetInt(q))
+	opOut.AssertScale()
+
+	return
+}
+
+// trackedMax sets opOut to the maximum of a and b.
+func trackedMax(a, b, opOut *big.Rat) {
+	if a.Cmp(b) >= 0 {
+		opOut.Set(a)
+	} else {
+		opOut.Set(b)
+	}
+}
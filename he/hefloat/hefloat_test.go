@@ -104,15 +104,21 @@ func TestHEFloat(t *testing.T) {
 			for _, testSet := range []func(tc *testContext, t *testing.T){
 				testParameters,
 				testEncoder,
+				testComparePrecision,
 				testEvaluatorAdd,
 				testEvaluatorSub,
 				testEvaluatorRescale,
 				testEvaluatorMul,
 				testEvaluatorMulThenAdd,
+				testEvaluatorConjugate,
+				testEvaluatorRotateRange,
+				testEvaluatorTracked,
 				testBridge,
 				testLinearTransformation,
 				testPolynomialEvaluator,
 				testComparisons,
+				testPooling,
+				testArgMax,
 				testinverse,
 			} {
 				testSet(tc, t)
@@ -398,6 +398,183 @@ func (eval *InverseEvaluator) GoldschmidtDivision(iters int, ct *rlwe.Ciphertext
 	return
 }
 
+// InverseNew evaluates y = 1/x on the input ciphertext with iterations of the Newton
+// iteration y = y * (2 - x * y), whose bit-precision doubles at each iteration.
+//
+// The iteration converges if the initial approximation y0 satisfies |1 - x * y0| < 1,
+// i.e. the values of x must be positive and y0 must lie in the open interval (0, 2/x).
+// The caller can provide such an initial approximation through initialGuess, for example
+// obtained by evaluating a low-degree polynomial approximation of 1/x. If initialGuess
+// is nil, y0 = 2 - x is used, which satisfies the convergence condition for x in the
+// open interval (0, 2) (for other ranges, see [InverseEvaluator.InversePositiveDomainNew],
+// which normalizes the input interval first).
+//
+// Each iteration consumes 2*params.LevelsConsumedPerRescaling() levels. If the
+// InverseEvaluator was instantiated with a he.Bootstrapper, ciphertexts are bootstrapped
+// when they run out of levels, otherwise an error is returned if the initial approximation
+// does not have enough remaining levels.
+func (eval *InverseEvaluator) InverseNew(ct *rlwe.Ciphertext, iterations int, initialGuess *rlwe.Ciphertext) (opOut *rlwe.Ciphertext, err error) {
+
+	if iterations < 1 {
+		return nil, fmt.Errorf("cannot InverseNew: iterations must be at least 1 but is %d", iterations)
+	}
+
+	params := eval.Parameters
+
+	levelsPerRescaling := params.LevelsConsumedPerRescaling()
+
+	btp := eval.Bootstrapper
+
+	var y *rlwe.Ciphertext
+	if initialGuess != nil {
+		y = initialGuess.Clone()
+	} else {
+		// y0 = 2 - x
+		y = ct.Clone()
+
+		if err = eval.Mul(y, -1, y); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Mul][y,-1,y]: %w", err)
+		}
+
+		if err = eval.Add(y, 2, y); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Add][y,2,y]: %w", err)
+		}
+	}
+
+	if depth := 2 * iterations * levelsPerRescaling; btp == nil && depth > y.Level() {
+		return nil, fmt.Errorf("cannot InverseNew: y.Level()=%d < depth=%d and he.Bootstrapper is nil", y.Level(), depth)
+	}
+
+	x := ct
+
+	for range iterations {
+
+		if btp != nil && x.Level() < btp.MinimumInputLevel()+levelsPerRescaling {
+			if x, err = btp.Bootstrap(x); err != nil {
+				return nil, fmt.Errorf("[he.Bootstrapper][Bootstrap][x]: %w", err)
+			}
+		}
+
+		if btp != nil && y.Level() < btp.MinimumInputLevel()+2*levelsPerRescaling {
+			if y, err = btp.Bootstrap(y); err != nil {
+				return nil, fmt.Errorf("[he.Bootstrapper][Bootstrap][y]: %w", err)
+			}
+		}
+
+		// e = 2 - x * y
+		var e *rlwe.Ciphertext
+		if e, err = eval.MulRelinNew(x, y); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][MulRelinNew][x,y]: %w", err)
+		}
+
+		if err = eval.Rescale(e, e); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Rescale][e,e]: %w", err)
+		}
+
+		if err = eval.Mul(e, -1, e); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Mul][e,-1,e]: %w", err)
+		}
+
+		if err = eval.Add(e, 2, e); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Add][e,2,e]: %w", err)
+		}
+
+		// y = y * e
+		if err = eval.MulRelin(y, e, y); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][MulRelin][y,e,y]: %w", err)
+		}
+
+		if err = eval.Rescale(y, y); err != nil {
+			return nil, fmt.Errorf("[hefloat.Evaluator][Rescale][y,y]: %w", err)
+		}
+	}
+
+	return y, nil
+}
+
+// EvaluateRational evaluates num(x)/den(x) on the input ciphertext, where num and den are the
+// numerator and denominator of a rational (e.g. Pade) approximation of the target function.
+// Both polynomials are evaluated with the [PolynomialEvaluator] and the division is carried
+// out with iterations of the Goldschmidt algorithm (see [InverseEvaluator.GoldschmidtDivision]),
+// whose bit-precision doubles at each iteration.
+//
+// The Goldschmidt iterations only converge if den(x) takes its values in the open interval
+// (0, 2) over the encrypted domain, thus the denominator must be normalized accordingly
+// (the normalization constant can be compensated in the numerator).
+//
+// The circuit consumes Depth(num) (resp. Depth(den)) levels for the numerator (resp. the
+// denominator), one level per iteration for the division and one level for the final product,
+// each weighted by params.LevelsConsumedPerRescaling(). If the InverseEvaluator was
+// instantiated with a he.Bootstrapper, ciphertexts are bootstrapped when they run out of levels.
+func (eval *InverseEvaluator) EvaluateRational(in *rlwe.Ciphertext, num, den *he.Polynomial, iterations int, opOut *rlwe.Ciphertext) (err error) {
+
+	if iterations < 1 {
+		return fmt.Errorf("cannot EvaluateRational: iterations must be at least 1 but is %d", iterations)
+	}
+
+	params := eval.Parameters
+
+	levelsPerRescaling := params.LevelsConsumedPerRescaling()
+
+	btp := eval.Bootstrapper
+
+	polyEval := NewPolynomialEvaluator(params, eval.EvaluatorForInverse)
+
+	var p *rlwe.Ciphertext
+	if p, err = polyEval.Evaluate(in, num, params.DefaultScale()); err != nil {
+		return fmt.Errorf("numerator: %w", err)
+	}
+
+	if err = eval.Rescale(p, p); err != nil {
+		return fmt.Errorf("numerator: rescale: %w", err)
+	}
+
+	var q *rlwe.Ciphertext
+	if q, err = polyEval.Evaluate(in, den, params.DefaultScale()); err != nil {
+		return fmt.Errorf("denominator: %w", err)
+	}
+
+	if err = eval.Rescale(q, q); err != nil {
+		return fmt.Errorf("denominator: rescale: %w", err)
+	}
+
+	// q = 1/den(x)
+	if err = eval.GoldschmidtDivision(iterations, q); err != nil {
+		return fmt.Errorf("division: %w", err)
+	}
+
+	if btp != nil && p.Level() < btp.MinimumInputLevel()+levelsPerRescaling {
+		if p, err = btp.Bootstrap(p); err != nil {
+			return fmt.Errorf("division: Bootstrap(p): %w", err)
+		}
+	}
+
+	if btp != nil && q.Level() < btp.MinimumInputLevel()+levelsPerRescaling {
+		if q, err = btp.Bootstrap(q); err != nil {
+			return fmt.Errorf("division: Bootstrap(q): %w", err)
+		}
+	}
+
+	if err = eval.MatchScalesForMul(p, q, params.DefaultScale()); err != nil {
+		return
+	}
+
+	// p = num(x) * (1/den(x))
+	if err = eval.MulRelin(p, q, p); err != nil {
+		return fmt.Errorf("postprocessing: mul(p, q): %w", err)
+	}
+
+	if err = eval.Rescale(p, p); err != nil {
+		return fmt.Errorf("postprocessing: rescale(p): %w", err)
+	}
+
+	opOut.ResizeQ(p.LevelQ())
+	opOut.ResizeDegree(p.Degree())
+	opOut.Copy(p)
+
+	return
+}
+
 // IntervalNormalization applies a modified version of Algorithm 2 of Efficient Homomorphic Evaluation on Large Intervals (https://eprint.iacr.org/2022/280)
 // to normalize the interval from [-Max/Fac, Max/Fac] to [-1*scaling, 1*scaling]. Also returns the encrypted normalization factor.
 //
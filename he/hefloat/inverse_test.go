@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/he/hefloat"
 	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
 	"github.com/Pro7ech/lattigo/ring"
@@ -49,6 +50,42 @@ func testinverse(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ct, int(ecd.Prec())-15, 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "InverseEvaluator/InverseNew"), func(t *testing.T) {
+
+		values, pt, ct := newTestVectors(tc, tc.encryptorSk, complex(0.5, 0), complex(1.5, 0), t)
+
+		want := make([]big.Float, params.MaxSlots())
+		for i := range want {
+			want[i] = *new(big.Float).Quo(one, &values[i][0])
+		}
+
+		invEval := hefloat.NewInverseEvaluator(params, eval, btp)
+
+		// Default initial guess y0 = 2 - x
+		res, err := invEval.InverseNew(ct, 7, nil)
+		require.NoError(t, err)
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, res, int(ecd.Prec())-15, 0, *printPrecisionStats, t)
+
+		// User-provided initial guess y0 = 1, which satisfies |1 - x * y0| < 1 over (0.5, 1.5)
+		ones := make([]big.Float, params.MaxSlots())
+		for i := range ones {
+			ones[i].SetPrec(params.EncodingPrecision()).SetInt64(1)
+		}
+
+		require.NoError(t, ecd.Encode(ones, pt))
+
+		initialGuess := hefloat.NewCiphertext(params, 1, pt.Level())
+		require.NoError(t, tc.encryptorSk.Encrypt(pt, initialGuess))
+
+		res, err = invEval.InverseNew(ct, 7, initialGuess)
+		require.NoError(t, err)
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, res, int(ecd.Prec())-15, 0, *printPrecisionStats, t)
+
+		// Rejects an invalid iteration count
+		_, err = invEval.InverseNew(ct, 0, nil)
+		require.Error(t, err)
+	})
+
 	t.Run(GetTestName(params, "InverseEvaluator/PositiveDomain"), func(t *testing.T) {
 
 		values, _, ct := newTestVectors(tc, enc, complex(valMin, 0), complex(valMax, 0), t)
@@ -141,4 +178,42 @@ func testinverse(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(params, tc.encoder, nil, want, have, int(ecd.Prec())-10, 0, *printPrecisionStats, t)
 	})
+
+	t.Run(GetTestName(params, "InverseEvaluator/EvaluateRational"), func(t *testing.T) {
+
+		values, _, ct := newTestVectors(tc, enc, complex(-1, 0), complex(1, 0), t)
+
+		prec := ecd.Prec()
+
+		// num(x)/den(x) = x/(1 + x^2/2), den(x) in [1, 1.5] in the domain [-1, 1]
+		num := bignum.NewPolynomial(bignum.Monomial, []big.Float{
+			*bignum.NewFloat(0, prec),
+			*bignum.NewFloat(1, prec),
+		}, nil)
+
+		den := bignum.NewPolynomial(bignum.Monomial, []big.Float{
+			*bignum.NewFloat(1, prec),
+			*bignum.NewFloat(0, prec),
+			*new(big.Float).Quo(one, bignum.NewFloat(2, prec)),
+		}, nil)
+
+		invEval := hefloat.NewInverseEvaluator(params, eval, btp)
+		require.NoError(t, invEval.EvaluateRational(ct, he.NewPolynomial(num), he.NewPolynomial(den), 7, ct))
+
+		have := make([]big.Float, params.MaxSlots())
+
+		require.NoError(t, ecd.Decode(dec.DecryptNew(ct), have))
+
+		want := make([]big.Float, params.MaxSlots())
+		for i := range have {
+			n := num.Evaluate(&values[i])
+			d := den.Evaluate(&values[i])
+			want[i] = *new(big.Float).Quo(&n[0], &d[0])
+		}
+
+		hefloat.VerifyTestVectors(params, tc.encoder, nil, want, have, int(ecd.Prec())-15, 0, *printPrecisionStats, t)
+
+		// Rejects an invalid iteration count
+		require.Error(t, invEval.EvaluateRational(ct, he.NewPolynomial(num), he.NewPolynomial(den), 0, ct))
+	})
 }
@@ -136,6 +136,60 @@ func testLinearTransformation(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, have, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "LinearTransformationEvaluator/EvaluateAndRescale"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		slots := ciphertext.Slots()
+
+		nonZeroDiags := []int{-15, -4, -1, 0, 1, 2, 3, 4, 15}
+
+		diagonals := make(he.Diagonals[bignum.Complex])
+		for _, i := range nonZeroDiags {
+			diagonals[i] = make([]bignum.Complex, slots)
+			for j := 0; j < slots; j++ {
+				diagonals[i][j].SetPrec(prec)
+				diagonals[i][j][0].SetFloat64(source.Float64(-1, 1))
+			}
+		}
+
+		ltparams := he.LinearTransformationParameters{
+			Indexes:       diagonals.Indexes(),
+			LevelQ:        ciphertext.Level(),
+			LevelP:        params.MaxLevelP(),
+			Scale:         params.GetScalingFactor(ciphertext.Scale, params.DefaultScale(), ciphertext.Level()),
+			LogDimensions: ciphertext.LogDimensions,
+		}
+
+		// Allocate the linear transformation
+		linTransf := he.NewLinearTransformation(params, ltparams)
+
+		// Encode on the linear transformation
+		require.NoError(t, he.EncodeLinearTransformation[bignum.Complex](tc.encoder, diagonals, linTransf))
+
+		galEls := ltparams.GaloisElements(params)
+
+		evk := rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		ltEval := he.NewLinearTransformationEvaluator(tc.evaluator.WithKey(evk))
+
+		buf := ltEval.NewHoistingBuffer(ciphertext.LevelQ(), params.MaxLevelP())
+
+		scale := ciphertext.Scale
+
+		require.NoError(t, ltEval.EvaluateAndRescale(ciphertext, linTransf, buf, ciphertext))
+
+		// The scale introduced by the transformation must have been consumed
+		require.True(t, ciphertext.Scale.InDelta(scale, float64(scale.Log2()-30)))
+
+		have := make([]bignum.Complex, len(values))
+		buff := make([]bignum.Complex, len(values))
+
+		diagonals.Evaluate(values, buff, have, ltparams, zero, add, muladd)
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, have, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
 	t.Run(GetTestName(params, "LinearTransformationEvaluator/BSGS=False"), func(t *testing.T) {
 
 		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
@@ -249,4 +303,118 @@ func testLinearTransformation(tc *testContext, t *testing.T) {
 
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, have, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
+
+	mulvec := func(a, b, c []bignum.Complex) {
+		d := &bignum.Complex{}
+		for i := range c {
+			mul(&a[i], &b[i], d)
+			c[i].Set(d)
+		}
+	}
+
+	clonevec := func(a []bignum.Complex) (b []bignum.Complex) {
+		b = make([]bignum.Complex, len(a))
+		for i := range a {
+			b[i] = *a[i].Clone()
+		}
+		return
+	}
+
+	newDiagonals := func(nonZeroDiags []int, slots int) he.Diagonals[bignum.Complex] {
+		diagonals := make(he.Diagonals[bignum.Complex])
+		for _, i := range nonZeroDiags {
+			diagonals[i] = make([]bignum.Complex, slots)
+			for j := 0; j < slots; j++ {
+				diagonals[i][j].SetPrec(prec)
+				diagonals[i][j][0].SetFloat64(source.Float64(-1, 1))
+			}
+		}
+		return diagonals
+	}
+
+	t.Run(GetTestName(params, "Diagonals/Transpose"), func(t *testing.T) {
+
+		slots := params.MaxSlots()
+
+		A := newDiagonals([]int{-7, -2, 0, 1, 3}, slots)
+		AT := A.Transpose(slots, clonevec)
+
+		v := make([]bignum.Complex, slots)
+		w := make([]bignum.Complex, slots)
+		for i := 0; i < slots; i++ {
+			v[i].SetPrec(prec)
+			v[i][0].SetFloat64(source.Float64(-1, 1))
+			w[i].SetPrec(prec)
+			w[i][0].SetFloat64(source.Float64(-1, 1))
+		}
+
+		ltparams := he.LinearTransformationParameters{LogDimensions: params.LogMaxDimensions()}
+
+		buff := make([]bignum.Complex, slots)
+		Av := make([]bignum.Complex, slots)
+		ATw := make([]bignum.Complex, slots)
+
+		A.Evaluate(v, buff, Av, ltparams, zero, add, muladd)
+		AT.Evaluate(w, buff, ATw, ltparams, zero, add, muladd)
+
+		// Checks the adjoint identity <A(v), w> = <v, A^T(w)>
+		var lhs, rhs complex128
+		for i := 0; i < slots; i++ {
+			lhs += Av[i].Complex128() * w[i].Complex128()
+			rhs += v[i].Complex128() * ATw[i].Complex128()
+		}
+
+		require.InDelta(t, real(lhs), real(rhs), 1e-8)
+		require.InDelta(t, imag(lhs), imag(rhs), 1e-8)
+	})
+
+	t.Run(GetTestName(params, "LinearTransformationEvaluator/Compose"), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		slots := ciphertext.Slots()
+
+		A := newDiagonals([]int{-4, -1, 0, 2}, slots)
+		B := newDiagonals([]int{-2, 0, 1, 3}, slots)
+
+		// Diagonals of the composite linear map applying B first and A second
+		AB := A.Compose(B, slots, add, mulvec, clonevec)
+
+		evalParams := func(diagonals he.Diagonals[bignum.Complex]) he.LinearTransformationParameters {
+			return he.LinearTransformationParameters{
+				Indexes:       diagonals.Indexes(),
+				LevelQ:        ciphertext.Level(),
+				LevelP:        params.MaxLevelP(),
+				Scale:         params.GetScalingFactor(ciphertext.Scale, params.DefaultScale(), ciphertext.Level()),
+				LogDimensions: ciphertext.LogDimensions,
+			}
+		}
+
+		// Reference: applies B then A separately in the clear
+		buff := make([]bignum.Complex, len(values))
+		tmp := make([]bignum.Complex, len(values))
+		want := make([]bignum.Complex, len(values))
+
+		B.Evaluate(values, buff, tmp, evalParams(B), zero, add, muladd)
+		A.Evaluate(tmp, buff, want, evalParams(A), zero, add, muladd)
+
+		// Evaluates the composite in a single BSGS pass
+		ltparams := evalParams(AB)
+
+		linTransf := he.NewLinearTransformation(params, ltparams)
+
+		require.NoError(t, he.EncodeLinearTransformation[bignum.Complex](tc.encoder, AB, linTransf))
+
+		galEls := ltparams.GaloisElements(params)
+
+		evk := rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...)
+
+		ltEval := he.NewLinearTransformationEvaluator(tc.evaluator.WithKey(evk))
+
+		buf := ltEval.NewHoistingBuffer(ciphertext.LevelQ(), params.MaxLevelP())
+
+		require.NoError(t, ltEval.Evaluate(ciphertext, linTransf, buf, ciphertext))
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, want, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
 }
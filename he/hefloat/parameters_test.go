@@ -40,6 +40,20 @@ func testParameters(tc *testContext, t *testing.T) {
 		}
 	})
 
+	t.Run(GetTestName(tc.params, "Parameters/MaxMessageLog2"), func(t *testing.T) {
+		params, err := hefloat.NewParametersFromLiteral(hefloat.ParametersLiteral{
+			LogN:            4,
+			LogQ:            []int{55, 45},
+			LogP:            []int{60},
+			LogDefaultScale: 45,
+		})
+		require.NoError(t, err)
+		// log2(Q[0]) - log2(scale) - 1 = 55 - 45 - 1 = 9 up to the
+		// deviation of the first prime from an exact power of two
+		require.InDelta(t, 9, params.MaxMessageLog2(0), 0.01)
+		require.InDelta(t, 54, params.MaxMessageLog2(1), 0.01)
+	})
+
 	t.Run(GetTestName(tc.params, "Parameters/Marshaller/Binary"), func(t *testing.T) {
 
 		bytes, err := tc.params.MarshalBinary()
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils"
 	"github.com/Pro7ech/lattigo/utils/bignum"
 	"github.com/Pro7ech/lattigo/utils/buffer"
 )
@@ -196,6 +197,21 @@ func (p Parameters) MaxDepth() int {
 	return p.MaxLevel() / p.LevelsConsumedPerRescaling()
 }
 
+// MaxMessageLog2 returns the log2 of the largest signed message that can be stored
+// in a plaintext at the given level without overflow, i.e. log2(QLvl(level)) -
+// log2(DefaultScale()) - 1, one bit being reserved for the sign.
+//
+// For example, parameters with a first prime of ~2^{55} and a default scaling
+// factor of 2^{45} can accommodate values as large as 2^{9} at level 0.
+// Exceeding this bound wraps the underlying integer plaintext around the modulus,
+// which silently corrupts the message.
+func (p Parameters) MaxMessageLog2(level int) float64 {
+	q := new(big.Float).SetInt(p.QLvl(level))
+	exp := q.MantExp(q)
+	mant, _ := q.Float64()
+	return float64(exp) + math.Log2(mant) - math.Log2(p.DefaultScale().Float64()) - 1
+}
+
 // LogQLvl returns the size of the modulus Q in bits at a specific level
 func (p Parameters) LogQLvl(level int) int {
 	tmp := p.QLvl(level)
@@ -272,6 +288,21 @@ func (p Parameters) GaloisElementsForTrace(logN int) []uint64 {
 	return rlwe.GaloisElementsForTrace(p, logN)
 }
 
+// GaloisElementsForDFT returns the list of Galois elements necessary to evaluate the
+// factorized homomorphic DFT (SlotsToCoeffs) and IDFT (CoeffsToSlots) linear
+// transformations over 2^{logSlots} slots, where levels[i] is the factorization
+// depth of the i-th matrix (see [DFTMatrixLiteral]).
+func (p Parameters) GaloisElementsForDFT(logSlots int, levels []int) (galEls []uint64) {
+	for _, dftType := range []DFTType{HomomorphicEncode, HomomorphicDecode} {
+		galEls = append(galEls, DFTMatrixLiteral{
+			Type:     dftType,
+			LogSlots: logSlots,
+			Levels:   levels,
+		}.GaloisElements(p)...)
+	}
+	return utils.GetDistincts(galEls)
+}
+
 // Equal compares two sets of parameters for equality.
 func (p Parameters) Equal(other *Parameters) bool {
 	return p.Parameters.Equal(&other.Parameters) && p.precisionMode == other.precisionMode
@@ -1,6 +1,9 @@
 package hefloat
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/rlwe"
 	"github.com/Pro7ech/lattigo/utils/bignum"
@@ -11,6 +14,11 @@ import (
 type PolynomialEvaluator struct {
 	Parameters Parameters
 	he.EvaluatorForPolynomial
+
+	// Bootstrapper is an optional he.Bootstrapper[rlwe.Ciphertext].
+	// If set, input ciphertexts that do not carry enough levels for the
+	// polynomial evaluation are automatically bootstrapped.
+	he.Bootstrapper[rlwe.Ciphertext]
 }
 
 func GetEncodedPolynomialVector(params Parameters, ecd *Encoder, polys *he.PolynomialVector, inputLevel int, inputScale, targetScale rlwe.Scale) (pspe *he.EncodedPolynomialVector, err error) {
@@ -36,6 +44,44 @@ func NewPolynomialEvaluator(params Parameters, eval he.Evaluator) *PolynomialEva
 	}
 }
 
+// WithBootstrapper returns an instance of the receiver with the provided he.Bootstrapper[rlwe.Ciphertext].
+// If set, ciphertexts given to [PolynomialEvaluator.Evaluate] that do not carry enough levels for the
+// polynomial evaluation are automatically bootstrapped before the evaluation.
+func (eval PolynomialEvaluator) WithBootstrapper(btp he.Bootstrapper[rlwe.Ciphertext]) *PolynomialEvaluator {
+	eval.Bootstrapper = btp
+	return &eval
+}
+
+// bootstrapIfNeeded refreshes ct if a he.Bootstrapper[rlwe.Ciphertext] is set and ct
+// does not carry enough levels to evaluate a polynomial of the given depth and still
+// end above the bootstrapper's minimum input level.
+func (eval PolynomialEvaluator) bootstrapIfNeeded(ct *rlwe.Ciphertext, p interface{}) (*rlwe.Ciphertext, error) {
+
+	btp := eval.Bootstrapper
+
+	if btp == nil {
+		return ct, nil
+	}
+
+	var depth int
+	switch p := p.(type) {
+	case *bignum.Polynomial:
+		depth = p.Depth()
+	case *he.Polynomial:
+		depth = p.Depth()
+	case *he.PolynomialVector:
+		depth = p.Depth()
+	default:
+		return ct, nil
+	}
+
+	if ct.Level() < depth*eval.Parameters.LevelsConsumedPerRescaling()+btp.MinimumInputLevel() {
+		return btp.Bootstrap(ct)
+	}
+
+	return ct, nil
+}
+
 // Evaluate evaluates a polynomial on the input Ciphertext in ceil(log2(deg+1)) levels.
 // Returns an error if the input ciphertext does not have enough levels to carry out the full polynomial evaluation.
 // Returns an error if something is wrong with the scale.
@@ -47,15 +93,89 @@ func NewPolynomialEvaluator(params Parameters, eval he.Evaluator) *PolynomialEva
 // pol: a *bignum.Polynomial, *Polynomial or *PolynomialVector
 // targetScale: the desired output scale. This value shouldn't differ too much from the original ciphertext scale. It can
 // for example be used to correct small deviations in the ciphertext scale and reset it to the default scale.
-func (eval PolynomialEvaluator) Evaluate(ct *rlwe.Ciphertext, p interface{}, targetScale rlwe.Scale) (opOut *rlwe.Ciphertext, err error) {
-	return he.EvaluatePolynomial(eval, ct, p, targetScale, &simEvaluator{eval.Parameters})
+// opts: optional he.EvaluateOption, such as he.WithMaxDepth to cap the number of levels consumed by the evaluation.
+func (eval PolynomialEvaluator) Evaluate(ct *rlwe.Ciphertext, p interface{}, targetScale rlwe.Scale, opts ...he.EvaluateOption) (opOut *rlwe.Ciphertext, err error) {
+	if ct, err = eval.bootstrapIfNeeded(ct, p); err != nil {
+		return nil, err
+	}
+	return he.EvaluatePolynomial(eval.EvaluatorForPolynomial, ct, p, targetScale, &simEvaluator{eval.Parameters}, opts...)
+}
+
+// EvaluateOnInterval evaluates a polynomial in Chebyshev basis approximated over the interval
+// [a, b] on the input ciphertext. The method first performs the change of basis
+// ct' = (2*ct - a - b)/(b - a) internally, including the rescaling, before delegating to
+// [PolynomialEvaluator.Evaluate]: it replaces the manual Mul, Add, Rescale sequence that is
+// otherwise required before evaluating a polynomial in Chebyshev basis. The change of basis
+// consumes params.LevelsConsumedPerRescaling() levels in addition to the levels consumed by
+// the polynomial evaluation. The input ciphertext is not modified.
+//
+// The interval must be the interval over which the Chebyshev approximation of the polynomial
+// was computed (e.g. the interval given to [bignum.ChebyshevApproximation]).
+//
+// If the polynomial is in Monomial basis, the change of basis is the identity: the interval
+// is ignored and the method is equivalent to [PolynomialEvaluator.Evaluate].
+func (eval PolynomialEvaluator) EvaluateOnInterval(ct *rlwe.Ciphertext, p interface{}, interval [2]float64, targetScale rlwe.Scale, opts ...he.EvaluateOption) (opOut *rlwe.Ciphertext, err error) {
+
+	var basis bignum.Basis
+	switch p := p.(type) {
+	case *bignum.Polynomial:
+		basis = p.Basis
+	case *he.Polynomial:
+		basis = p.Basis
+	case *he.PolynomialVector:
+		for _, poly := range p.Value {
+			basis = poly.Basis
+			break
+		}
+	default:
+		return nil, fmt.Errorf("cannot EvaluateOnInterval: invalid polynomial type: must be either *bignum.Polynomial, *he.Polynomial or *he.PolynomialVector, but is %T", p)
+	}
+
+	if basis == bignum.Chebyshev {
+
+		a, b := interval[0], interval[1]
+
+		if a >= b {
+			return nil, fmt.Errorf("cannot EvaluateOnInterval: invalid interval [%f, %f]: must satisfy a < b", a, b)
+		}
+
+		prec := eval.Parameters.EncodingPrecision()
+
+		num := new(big.Float).SetPrec(prec).SetFloat64(b)
+		num.Sub(num, new(big.Float).SetPrec(prec).SetFloat64(a))
+
+		// 2 / (b-a)
+		scalar := new(big.Float).Quo(new(big.Float).SetPrec(prec).SetInt64(2), num)
+
+		// (-b-a) / (b-a)
+		constant := new(big.Float).SetPrec(prec).SetFloat64(b)
+		constant.Neg(constant)
+		constant.Sub(constant, new(big.Float).SetPrec(prec).SetFloat64(a))
+		constant.Quo(constant, num)
+
+		ct = ct.Clone()
+
+		if err = eval.Mul(ct, scalar, ct); err != nil {
+			return nil, fmt.Errorf("cannot EvaluateOnInterval: change of basis: %w", err)
+		}
+
+		if err = eval.Add(ct, constant, ct); err != nil {
+			return nil, fmt.Errorf("cannot EvaluateOnInterval: change of basis: %w", err)
+		}
+
+		if err = eval.Rescale(ct, ct); err != nil {
+			return nil, fmt.Errorf("cannot EvaluateOnInterval: change of basis: %w", err)
+		}
+	}
+
+	return eval.Evaluate(ct, p, targetScale, opts...)
 }
 
 // EvaluateFromPowerBasis evaluates a polynomial using the provided PowerBasis, holding pre-computed powers of X.
 // This method is the same as Evaluate except that the encrypted input is a PowerBasis.
 // See Evaluate for additional information.
-func (eval PolynomialEvaluator) EvaluateFromPowerBasis(pb *he.PowerBasis, p interface{}, targetScale rlwe.Scale) (opOut *rlwe.Ciphertext, err error) {
-	return he.EvaluatePolynomial(eval, pb, p, targetScale, &simEvaluator{eval.Parameters})
+func (eval PolynomialEvaluator) EvaluateFromPowerBasis(pb *he.PowerBasis, p interface{}, targetScale rlwe.Scale, opts ...he.EvaluateOption) (opOut *rlwe.Ciphertext, err error) {
+	return he.EvaluatePolynomial(eval.EvaluatorForPolynomial, pb, p, targetScale, &simEvaluator{eval.Parameters}, opts...)
 }
 
 // CoefficientGetter is a struct that implements the
@@ -108,3 +228,11 @@ func (eval defaultCircuitEvaluatorForPolynomial) EvaluatePatersonStockmeyerPolyn
 	coeffGetter := he.CoefficientGetter[bignum.Complex](&CoefficientGetter{Values: make([]bignum.Complex, pb.Value[1].Slots())})
 	return he.EvaluatePatersonStockmeyerPolynomialVector(eval, poly, coeffGetter, pb)
 }
+
+// EvaluateHornerPolynomialVector evaluates the PolynomialVector on the input ciphertext x with a plain Horner scheme.
+// This method implements the interface he.EvaluatorForHornerPolynomial, enabling the automatic fallback to the
+// Horner evaluation for polynomials of degree smaller than he.HornerDegreeThreshold.
+func (eval defaultCircuitEvaluatorForPolynomial) EvaluateHornerPolynomialVector(poly *he.PolynomialVector, x *rlwe.Ciphertext, targetScale rlwe.Scale) (res *rlwe.Ciphertext, err error) {
+	coeffGetter := he.CoefficientGetter[bignum.Complex](&CoefficientGetter{Values: make([]bignum.Complex, x.Slots())})
+	return he.EvaluateHornerPolynomialVector(eval, poly, coeffGetter, x, targetScale)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
 	"github.com/Pro7ech/lattigo/utils/bignum"
 	"github.com/stretchr/testify/require"
 )
@@ -52,6 +53,96 @@ func testPolynomialEvaluator(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "PolynomialEvaluator/Evaluate/PolySingle/Horner"), func(t *testing.T) {
+
+		if params.MaxLevel() < 4 {
+			t.Skip("skipping test for params max level < 4")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		coeffs := []big.Float{
+			*bignum.NewFloat(1, prec),
+			*bignum.NewFloat(1, prec),
+			*new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(2, prec)),
+			*new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(6, prec)),
+		}
+
+		poly := bignum.NewPolynomial(bignum.Monomial, coeffs, nil)
+
+		for i := range values {
+			values[i] = *poly.Evaluate(&values[i])
+		}
+
+		threshold := he.HornerDegreeThreshold
+		defer func() { he.HornerDegreeThreshold = threshold }()
+
+		// Forces the Horner fallback
+		he.HornerDegreeThreshold = poly.Degree() + 1
+
+		resHorner, err := polyEval.Evaluate(ciphertext, poly, ciphertext.Scale)
+		require.NoError(t, err)
+
+		// Horner consumes deg(poly) levels (of which one is the pending rescaling)
+		require.Equal(t, ciphertext.Level()-(poly.Degree()-1)*params.LevelsConsumedPerRescaling(), resHorner.Level())
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, resHorner, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Disables the Horner fallback to verify precision parity with Paterson-Stockmeyer
+		he.HornerDegreeThreshold = 0
+
+		resPS, err := polyEval.Evaluate(ciphertext, poly, ciphertext.Scale)
+		require.NoError(t, err)
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, resPS, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(params, "PolynomialEvaluator/EvaluateOnInterval/Chebyshev/Sin"), func(t *testing.T) {
+
+		if params.MaxLevel() < 4 {
+			t.Skip("skipping test for params max level < 4")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -2, 2, t)
+
+		prec := tc.encoder.Prec()
+
+		interval := bignum.Interval{
+			Nodes: 15,
+			A:     *bignum.NewFloat(-2, prec),
+			B:     *bignum.NewFloat(2, prec),
+		}
+
+		poly := bignum.ChebyshevApproximation(bignum.Sin, interval)
+
+		for i := range values {
+			values[i] = *poly.Evaluate(&values[i])
+		}
+
+		// The change of basis is carried out internally, including the rescaling
+		res, err := polyEval.EvaluateOnInterval(ciphertext, poly, [2]float64{-2, 2}, ciphertext.Scale)
+		require.NoError(t, err)
+
+		// Matches the manual Mul, Add, Rescale, Evaluate sequence
+		manual := ciphertext.Clone()
+		scalar, constant := poly.ChangeOfBasis()
+		require.NoError(t, tc.evaluator.Mul(manual, scalar, manual))
+		require.NoError(t, tc.evaluator.Add(manual, constant, manual))
+		require.NoError(t, tc.evaluator.Rescale(manual, manual))
+
+		want, err := polyEval.Evaluate(manual, poly, ciphertext.Scale)
+		require.NoError(t, err)
+		require.Equal(t, want.Level(), res.Level())
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, res, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+
+		// Rejects an empty interval
+		_, err = polyEval.EvaluateOnInterval(ciphertext, poly, [2]float64{2, -2}, ciphertext.Scale)
+		require.Error(t, err)
+	})
+
 	t.Run(GetTestName(params, "PolynomialEvaluator/Evaluate/PolyVector/Exp"), func(t *testing.T) {
 
 		if params.MaxLevel() < 3 {
@@ -96,6 +187,45 @@ func testPolynomialEvaluator(tc *testContext, t *testing.T) {
 		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, ciphertext, params.LogDefaultScale(), 0, *printPrecisionStats, t)
 	})
 
+	t.Run(GetTestName(params, "PolynomialEvaluator/Evaluate/Bootstrap"), func(t *testing.T) {
+
+		if params.MaxLevel() < 3 {
+			t.Skip("skipping test for params max level < 3")
+		}
+
+		values, _, ciphertext := newTestVectors(tc, tc.encryptorSk, -1, 1, t)
+
+		prec := tc.encoder.Prec()
+
+		coeffs := []big.Float{
+			*bignum.NewFloat(1, prec),
+			*bignum.NewFloat(1, prec),
+			*new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(2, prec)),
+			*new(big.Float).Quo(bignum.NewFloat(1, prec), bignum.NewFloat(6, prec)),
+		}
+
+		poly := bignum.NewPolynomial(bignum.Monomial, coeffs, nil)
+
+		for i := range values {
+			values[i] = *poly.Evaluate(&values[i])
+		}
+
+		// Drops the ciphertext below the level required by the evaluation
+		tc.evaluator.DropLevel(ciphertext, ciphertext.Level()-params.LevelsConsumedPerRescaling())
+
+		// Without a bootstrapper the evaluation must fail
+		_, err = polyEval.Evaluate(ciphertext, poly, ciphertext.Scale)
+		require.Error(t, err)
+
+		btp := bootstrapping.NewSecretKeyBootstrapper(params, tc.sk)
+
+		// With a bootstrapper the input is automatically refreshed
+		res, err := polyEval.WithBootstrapper(btp).Evaluate(ciphertext, poly, ciphertext.Scale)
+		require.NoError(t, err)
+
+		hefloat.VerifyTestVectors(params, tc.encoder, tc.decryptor, values, res, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
 	t.Run(GetTestName(params, "PolynomialEvaluator/Evaluate/PolyVectorEncoded/Exp"), func(t *testing.T) {
 
 		if params.MaxLevel() < 3 {
@@ -0,0 +1,207 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// GaloisElementsForPooling returns the list of Galois elements required by the methods
+// [Evaluator.SumPool] and [Evaluator.MaxPool] for the given window shape, over a ciphertext
+// whose slots are interpreted as a 2D matrix of dimensions logDimensions packed row-major.
+func GaloisElementsForPooling(params Parameters, windowShape []int, logDimensions ring.Dimensions) (galEls []uint64) {
+
+	cols := 1 << logDimensions.Cols
+
+	for a := 0; a < windowShape[0]; a++ {
+		for b := 0; b < windowShape[1]; b++ {
+			if k := a*cols + b; k != 0 {
+				galEls = append(galEls, params.GaloisElement(k))
+			}
+		}
+	}
+
+	return
+}
+
+// poolingGeometry validates the pooling inputs and returns the dimensions of the
+// 2D matrix packed in the slots of ct.
+func (eval Evaluator) poolingGeometry(ct *rlwe.Ciphertext, windowShape, stride []int) (rows, cols int, err error) {
+
+	if ct.Degree() != 1 {
+		return 0, 0, fmt.Errorf("invalid ciphertext: degree should be 1 but is %d", ct.Degree())
+	}
+
+	if len(windowShape) != 2 || len(stride) != 2 {
+		return 0, 0, fmt.Errorf("invalid inputs: windowShape and stride must both have 2 dimensions")
+	}
+
+	rows = 1 << ct.LogDimensions.Rows
+	cols = 1 << ct.LogDimensions.Cols
+
+	if h, w := windowShape[0], windowShape[1]; h < 1 || w < 1 || h > rows || w > cols {
+		return 0, 0, fmt.Errorf("invalid inputs: windowShape=%v must be contained in the slot dimensions [%d, %d]", windowShape, rows, cols)
+	}
+
+	if stride[0] < 1 || stride[1] < 1 {
+		return 0, 0, fmt.Errorf("invalid inputs: stride=%v must be positive", stride)
+	}
+
+	return
+}
+
+// pool rotates ct by each non-zero offset of the window and combines the rotated copies
+// with the provided combine function, using a single shared decomposition if the
+// parameters have auxiliary primes.
+func (eval Evaluator) pool(ct *rlwe.Ciphertext, windowShape []int, cols int, buf rlwe.HoistingBuffer, combine func(acc, ctRot *rlwe.Ciphertext) (*rlwe.Ciphertext, error)) (acc *rlwe.Ciphertext, err error) {
+
+	params := eval.Parameters()
+
+	LevelQ := ct.Level()
+	LevelP := params.MaxLevelP()
+
+	hoisted := LevelP > -1
+
+	if hoisted {
+		eval.FillHoistingBuffer(LevelQ, LevelP, ct.Q[1], ct.IsNTT, buf)
+	}
+
+	acc = ct.Clone()
+	ctRot := rlwe.NewCiphertext(params, 1, LevelQ, -1)
+
+	for a := 0; a < windowShape[0]; a++ {
+		for b := 0; b < windowShape[1]; b++ {
+
+			k := a*cols + b
+
+			if k == 0 {
+				continue
+			}
+
+			if hoisted {
+				err = eval.AutomorphismHoisted(ct, buf, params.GaloisElement(k), ctRot)
+			} else {
+				err = eval.Rotate(ct, k, ctRot)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("cannot rotate by %d: %w", k, err)
+			}
+
+			if acc, err = combine(acc, ctRot); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return
+}
+
+// maskAnchors zeroes all slots of acc except the valid pooling anchors, i.e. the
+// positions (i, j) with i = 0 mod stride[0], j = 0 mod stride[1] and whose window
+// fits in the slot dimensions. This consumes one rescaling level.
+func (eval Evaluator) maskAnchors(acc *rlwe.Ciphertext, windowShape, stride []int, rows, cols int) (err error) {
+
+	mask := make([]float64, acc.Slots())
+
+	for i := 0; i+windowShape[0] <= rows; i += stride[0] {
+		for j := 0; j+windowShape[1] <= cols; j += stride[1] {
+			mask[i*cols+j] = 1
+		}
+	}
+
+	// The encoder lays the values over 2^{LogDimensions.Cols} slots, so the metadata
+	// is flattened for the duration of the multiplication to cover the full row-major
+	// vector.
+	dims := acc.LogDimensions
+	acc.LogDimensions = ring.Dimensions{Rows: 0, Cols: dims.Rows + dims.Cols}
+	defer func() { acc.LogDimensions = dims }()
+
+	if err = eval.Mul(acc, mask, acc); err != nil {
+		return err
+	}
+
+	return eval.Rescale(acc, acc)
+}
+
+// SumPool sums, for each valid anchor position, the slots of the window anchored at this
+// position, treating the slots of ct as a 2D matrix of dimensions ct.LogDimensions packed
+// row-major.
+//
+// The result for the window anchored at position (i, j) is written in the slot (i, j) of
+// opOut. Valid anchors are the positions that are multiples of the stride and whose window
+// fits in the slot dimensions without wrapping around.
+//
+// If stride = [1, 1] every slot is a valid anchor and no masking is applied: the windows
+// of the last rows (resp. columns) then wrap around the matrix boundaries. Otherwise the
+// non-anchor slots are zeroed, which consumes one rescaling level.
+//
+// The operation uses windowShape[0]*windowShape[1]-1 rotations, sharing a single
+// decomposition (see [Evaluator.FillHoistingBuffer]) if the parameters have auxiliary
+// primes. The necessary Galois keys can be generated with the elements returned by
+// [GaloisElementsForPooling].
+func (eval Evaluator) SumPool(ct *rlwe.Ciphertext, windowShape, stride []int, buf rlwe.HoistingBuffer, opOut *rlwe.Ciphertext) (err error) {
+
+	rows, cols, err := eval.poolingGeometry(ct, windowShape, stride)
+	if err != nil {
+		return fmt.Errorf("cannot SumPool: %w", err)
+	}
+
+	acc, err := eval.pool(ct, windowShape, cols, buf, func(acc, ctRot *rlwe.Ciphertext) (*rlwe.Ciphertext, error) {
+		return acc, eval.Add(acc, ctRot, acc)
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot SumPool: %w", err)
+	}
+
+	if stride[0] > 1 || stride[1] > 1 {
+		if err = eval.maskAnchors(acc, windowShape, stride, rows, cols); err != nil {
+			return fmt.Errorf("cannot SumPool: %w", err)
+		}
+	}
+
+	opOut.ResizeQ(acc.LevelQ())
+	opOut.ResizeDegree(acc.Degree())
+	opOut.Copy(acc)
+
+	return
+}
+
+// MaxPool returns, for each valid anchor position, the maximum of the slots of the window
+// anchored at this position, treating the slots of ct as a 2D matrix of dimensions
+// ct.LogDimensions packed row-major. See [Evaluator.SumPool] for the anchor, stride and
+// masking semantics.
+//
+// The maximum is computed with windowShape[0]*windowShape[1]-1 sequential evaluations of
+// [ComparisonEvaluator.Max], whose constraints apply to each pair of rotated operands
+// (values in [-1, 1], matching scales, see its doc for the precision of the underlying
+// sign approximation). Each evaluation consumes the depth of the minimax composite sign
+// polynomial, thus the provided [ComparisonEvaluator] should be instantiated with a
+// [he.Bootstrapper] for all but the smallest windows.
+func (eval Evaluator) MaxPool(cmp *ComparisonEvaluator, ct *rlwe.Ciphertext, windowShape, stride []int, buf rlwe.HoistingBuffer, opOut *rlwe.Ciphertext) (err error) {
+
+	rows, cols, err := eval.poolingGeometry(ct, windowShape, stride)
+	if err != nil {
+		return fmt.Errorf("cannot MaxPool: %w", err)
+	}
+
+	acc, err := eval.pool(ct, windowShape, cols, buf, cmp.Max)
+
+	if err != nil {
+		return fmt.Errorf("cannot MaxPool: %w", err)
+	}
+
+	if stride[0] > 1 || stride[1] > 1 {
+		if err = eval.maskAnchors(acc, windowShape, stride, rows, cols); err != nil {
+			return fmt.Errorf("cannot MaxPool: %w", err)
+		}
+	}
+
+	opOut.ResizeQ(acc.LevelQ())
+	opOut.ResizeDegree(acc.Degree())
+	opOut.Copy(acc)
+
+	return
+}
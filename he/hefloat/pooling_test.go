@@ -0,0 +1,126 @@
+package hefloat_test
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/Pro7ech/lattigo/he/hefloat/bootstrapping"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+	"github.com/stretchr/testify/require"
+)
+
+func testPooling(tc *testContext, t *testing.T) {
+
+	params := tc.params
+	enc := tc.encryptorSk
+	ecd := tc.encoder
+	dec := tc.decryptor
+	kgen := tc.kgen
+	sk := tc.sk
+
+	t.Run(GetTestName(params, "Evaluator/SumPool"), func(t *testing.T) {
+
+		values, _, ct := newTestVectors(tc, enc, complex(-1, -1), complex(1, 1), t)
+
+		// Interprets the slots as a 4 x Slots/4 matrix
+		defaultDims := ct.LogDimensions
+		ct.LogDimensions = ring.Dimensions{Rows: 2, Cols: ct.LogSlots() - 2}
+
+		rows := 1 << ct.LogDimensions.Rows
+		cols := 1 << ct.LogDimensions.Cols
+
+		windowShape := []int{2, 2}
+		stride := []int{2, 2}
+
+		galEls := hefloat.GaloisElementsForPooling(params, windowShape, ct.LogDimensions)
+
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(galEls, sk)...))
+
+		buf := eval.NewHoistingBuffer(ct.Level(), params.MaxLevelP())
+
+		// Invalid inputs
+		require.Error(t, eval.SumPool(ct, []int{2}, stride, buf, ct))
+		require.Error(t, eval.SumPool(ct, []int{rows + 1, 2}, stride, buf, ct))
+		require.Error(t, eval.SumPool(ct, windowShape, []int{0, 1}, buf, ct))
+
+		require.NoError(t, eval.SumPool(ct, windowShape, stride, buf, ct))
+
+		// Restores the default layout for decoding
+		ct.LogDimensions = defaultDims
+
+		want := make([]bignum.Complex, len(values))
+		for i := range want {
+			want[i].SetPrec(ecd.Prec())
+		}
+
+		for i := 0; i+windowShape[0] <= rows; i += stride[0] {
+			for j := 0; j+windowShape[1] <= cols; j += stride[1] {
+				acc := &want[i*cols+j]
+				for a := 0; a < windowShape[0]; a++ {
+					for b := 0; b < windowShape[1]; b++ {
+						acc.Add(acc, &values[(i+a)*cols+(j+b)])
+					}
+				}
+			}
+		}
+
+		hefloat.VerifyTestVectors(params, ecd, dec, want, ct, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+
+	t.Run(GetTestName(params, "Evaluator/MaxPool"), func(t *testing.T) {
+
+		values, _, ct := newTestVectors(tc, enc, complex(-0.5, 0), complex(0.5, 0), t)
+
+		rows := 1 << ct.LogDimensions.Rows
+		cols := 1 << ct.LogDimensions.Cols
+
+		windowShape := []int{1, 2}
+		stride := []int{1, 2}
+
+		btp := bootstrapping.NewSecretKeyBootstrapper(params, sk)
+
+		galKeys := kgen.GenGaloisKeysNew(galoisElementsForMaxPool(params, windowShape, ct.LogDimensions), sk)
+
+		eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), galKeys...))
+
+		cmp := hefloat.NewComparisonEvaluator(params, eval, btp)
+
+		buf := eval.NewHoistingBuffer(ct.Level(), params.MaxLevelP())
+
+		require.NoError(t, eval.MaxPool(cmp, ct, windowShape, stride, buf, ct))
+
+		want := make([]bignum.Complex, len(values))
+		for i := range want {
+			want[i].SetPrec(ecd.Prec())
+		}
+
+		for i := 0; i+windowShape[0] <= rows; i += stride[0] {
+			for j := 0; j+windowShape[1] <= cols; j += stride[1] {
+				max := &want[i*cols+j]
+				max.Set(&values[i*cols+j])
+				for a := 0; a < windowShape[0]; a++ {
+					for b := 0; b < windowShape[1]; b++ {
+						if v := &values[(i+a)*cols+(j+b)]; max[0].Cmp(&v[0]) == -1 {
+							max.Set(v)
+						}
+					}
+				}
+			}
+		}
+
+		hefloat.VerifyTestVectors(params, ecd, dec, want, ct, params.LogDefaultScale(), 0, *printPrecisionStats, t)
+	})
+}
+
+// galoisElementsForMaxPool returns the Galois elements for the pooling rotations along
+// with the element for the complex conjugation if the ring type is standard, as required
+// by the underlying [hefloat.ComparisonEvaluator].
+func galoisElementsForMaxPool(params hefloat.Parameters, windowShape []int, logDimensions ring.Dimensions) (galEls []uint64) {
+	galEls = hefloat.GaloisElementsForPooling(params, windowShape, logDimensions)
+	if params.RingType() == ring.Standard {
+		galEls = append(galEls, params.GaloisElementForComplexConjugation())
+	}
+	return
+}
@@ -88,6 +88,32 @@ func GetPrecisionStats(params Parameters, encoder *Encoder, decryptor *rlwe.Decr
 	return getPrecisionStatsF128(params, encoder, decryptor, want, have, logprec, computeDCF)
 }
 
+// ComparePrecision decrypts and decodes ctA and ctB and returns a PrecisionStats struct of their
+// element-wise agreement, ctA being treated as the reference. It is a testing and diagnostic
+// convenience to check that two evaluation paths of the same circuit produce matching results
+// to a given number of bits, without having to recompute the circuit in plaintext.
+func ComparePrecision(params Parameters, encoder *Encoder, decryptor *rlwe.Decryptor, ctA, ctB *rlwe.Ciphertext) (prec PrecisionStats) {
+
+	ptA := decryptor.DecryptNew(ctA)
+
+	if encoder.Prec() <= 53 {
+		want := make([]complex128, ptA.Slots())
+		if err := encoder.Decode(ptA, want); err != nil {
+			// Sanity check, this error should never happen.
+			panic(err)
+		}
+		return GetPrecisionStats(params, encoder, decryptor, want, ctB, 0, false)
+	}
+
+	want := make([]bignum.Complex, ptA.Slots())
+	if err := encoder.Decode(ptA, want); err != nil {
+		// Sanity check, this error should never happen.
+		panic(err)
+	}
+
+	return GetPrecisionStats(params, encoder, decryptor, want, ctB, 0, false)
+}
+
 func VerifyTestVectors(params Parameters, encoder *Encoder, decryptor *rlwe.Decryptor, valuesWant, valuesHave interface{}, log2MinPrec int, logprec float64, printPrecisionStats bool, t *testing.T) {
 
 	precStats := GetPrecisionStats(params, encoder, decryptor, valuesWant, valuesHave, logprec, false)
@@ -0,0 +1,31 @@
+package hefloat_test
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/hefloat"
+	"github.com/stretchr/testify/require"
+)
+
+func testComparePrecision(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName(tc.params, "ComparePrecision"), func(t *testing.T) {
+
+		_, pt, ctA := newTestVectors(tc, tc.encryptorSk, -1-1i, 1+1i, t)
+
+		// Second, independent encryption of the same plaintext
+		ctB := hefloat.NewCiphertext(tc.params, 1, pt.Level())
+		require.NoError(t, tc.encryptorSk.Encrypt(pt, ctB))
+
+		prec := hefloat.ComparePrecision(tc.params, tc.encoder, tc.decryptor, ctA, ctB)
+
+		// Two fresh encryptions of the same plaintext must agree up to the encryption noise
+		minPrec := float64(tc.params.LogDefaultScale() - tc.params.LogN() - 2)
+		if minPrec < 0 {
+			minPrec = 0
+		}
+
+		require.GreaterOrEqual(t, prec.AvgPrec.Real, minPrec)
+		require.GreaterOrEqual(t, prec.AvgPrec.Imag, minPrec)
+	})
+}
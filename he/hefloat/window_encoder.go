@@ -0,0 +1,70 @@
+package hefloat
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// WindowEncoder is an encoder for sliding windows of real values encoded in the
+// coefficient domain (IsBatched = false). It keeps the quantized encoding of the
+// current window and, on each [WindowEncoder.Push], shifts the previously
+// quantized coefficients by one and encodes only the entering value, instead of
+// re-quantizing the whole window. Since the quantization is carried out
+// independently on each coefficient, the resulting plaintext is identical to the
+// one obtained by re-encoding the shifted window from scratch, at a fraction of
+// the cost, which makes it suitable for streaming pipelines that repeatedly
+// encode overlapping vectors.
+type WindowEncoder struct {
+	ecd  *Encoder
+	size int
+	buff *rlwe.Plaintext
+}
+
+// NewWindowEncoder instantiates a [WindowEncoder] over windows of size real
+// values, encoded at the given level and scale. The window starts filled with
+// zeros and slides by one value per [WindowEncoder.Push], the oldest value
+// being stored in the first coefficient. The method returns an error if size
+// is not in [1, N].
+func NewWindowEncoder(ecd *Encoder, size, level int, scale rlwe.Scale) (*WindowEncoder, error) {
+
+	if size < 1 || size > ecd.parameters.N() {
+		return nil, fmt.Errorf("invalid window size: %d not in [1, %d]", size, ecd.parameters.N())
+	}
+
+	buff := NewPlaintext(ecd.parameters, level)
+	buff.Scale = scale
+	buff.IsBatched = false
+	buff.IsNTT = false
+
+	return &WindowEncoder{ecd: ecd, size: size, buff: buff}, nil
+}
+
+// Push slides the window by one value: the oldest value is dropped, the
+// remaining ones are shifted down by one coefficient and value is encoded in
+// the freed coefficient. The cost is a copy of the already quantized
+// coefficients plus the quantization of a single value: no transform over the
+// whole window is involved.
+func (we *WindowEncoder) Push(value float64) {
+
+	size := we.size
+
+	for j := range we.buff.Q {
+		p := we.buff.Q[j]
+		copy(p[:size-1], p[1:size])
+	}
+
+	rQ := we.ecd.parameters.RingQ().AtLevel(we.buff.Level())
+
+	SingleFloat64ToFixedPointCRT(rQ, size-1, value, we.buff.Scale.Float64(), we.buff.Q)
+}
+
+// GetPlaintextNew returns the encoding of the current window on a new plaintext,
+// identical to the one obtained by encoding the window with
+// [Encoder.EncodeCoeffs].
+func (we *WindowEncoder) GetPlaintextNew() (pt *rlwe.Plaintext) {
+	pt = we.buff.Clone()
+	we.ecd.parameters.RingQ().AtLevel(pt.Level()).NTT(pt.Q, pt.Q)
+	pt.IsNTT = true
+	return
+}
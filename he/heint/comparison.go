@@ -0,0 +1,89 @@
+package heint
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// IsZero writes in each slot of opOut an encryption of 1 if the corresponding slot of
+// op0 encrypts 0, and an encryption of 0 otherwise. The comparison relies on Fermat's
+// little theorem: x^{T-1} = 1 mod T for all x != 0 when the plaintext modulus T is
+// prime, thus opOut = 1 - op0^{T-1}. The procedure will return an error if T is not
+// prime (notably if the base plaintext modulus power is not 1).
+//
+// The exponentiation is evaluated with a square-and-multiply chain of ceil(log2(T-1))
+// sequential scale-invariant (BFV-style) multiplications, i.e. the multiplicative
+// depth is log2(T). No level is consumed, but each invariant tensoring increases the
+// noise by a constant factor, so the modulus must be large enough to absorb log2(T)
+// of them.
+//
+// The procedure will return an error if the evaluator was not created with a
+// relinearization key.
+func (eval Evaluator) IsZero(op0, opOut *rlwe.Ciphertext) (err error) {
+
+	T := eval.parameters.PlaintextModulus()
+
+	if eval.parameters.BasePlaintextModulusPower() != 1 {
+		return fmt.Errorf("cannot IsZero: the plaintext modulus must be prime but is a prime power")
+	}
+
+	if !ring.IsPrime(T) {
+		return fmt.Errorf("cannot IsZero: the plaintext modulus T=%d must be prime", T)
+	}
+
+	// Evaluates op0^{T-1} with a right-to-left square-and-multiply chain
+	var acc *rlwe.Ciphertext
+	pow := op0.Clone()
+
+	for e := T - 1; e != 0; e >>= 1 {
+
+		if e&1 == 1 {
+			if acc == nil {
+				acc = pow.Clone()
+			} else if acc, err = eval.MulRelinScaleInvariantNew(acc, pow); err != nil {
+				return fmt.Errorf("cannot IsZero: %w", err)
+			}
+		}
+
+		if e>>1 != 0 {
+			if pow, err = eval.MulRelinScaleInvariantNew(pow, pow); err != nil {
+				return fmt.Errorf("cannot IsZero: %w", err)
+			}
+		}
+	}
+
+	// opOut = 1 - op0^{T-1}
+	if err = eval.Mul(acc, T-1, acc); err != nil {
+		return fmt.Errorf("cannot IsZero: %w", err)
+	}
+
+	if err = eval.Add(acc, uint64(1), acc); err != nil {
+		return fmt.Errorf("cannot IsZero: %w", err)
+	}
+
+	opOut.ResizeQ(acc.LevelQ())
+	opOut.ResizeDegree(acc.Degree())
+	opOut.Copy(acc)
+
+	return
+}
+
+// IsEqual writes in each slot of opOut an encryption of 1 if the corresponding slots
+// of op0 and op1 encrypt the same value, and an encryption of 0 otherwise, by applying
+// [Evaluator.IsZero] on op0 - op1. See [Evaluator.IsZero] for the requirements on the
+// plaintext modulus and the multiplicative depth of the circuit.
+func (eval Evaluator) IsEqual(op0, op1 *rlwe.Ciphertext, opOut *rlwe.Ciphertext) (err error) {
+
+	diff, err := eval.SubNew(op0, op1)
+	if err != nil {
+		return fmt.Errorf("cannot IsEqual: %w", err)
+	}
+
+	if err = eval.IsZero(diff, opOut); err != nil {
+		return fmt.Errorf("cannot IsEqual: %w", err)
+	}
+
+	return
+}
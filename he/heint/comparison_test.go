@@ -0,0 +1,66 @@
+package heint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testComparison(tc *testContext, t *testing.T) {
+
+	lvl := tc.params.MaxLevel()
+
+	t.Run(GetTestName("Comparison/IsZero", tc.params, lvl), func(t *testing.T) {
+
+		values, _, ciphertext := newTestVectorsLvl(lvl, tc.params.NewScale(1), tc, tc.encryptorSk)
+
+		if tc.params.BasePlaintextModulusPower() != 1 {
+			require.Error(t, tc.evaluator.IsZero(ciphertext, ciphertext))
+			t.Skip("Skipping: the plaintext modulus is a prime power")
+		}
+
+		T := tc.params.PlaintextModulus()
+
+		if tc.params.LogPlaintextModulus() > 10 {
+			t.Skip("Skipping: insufficient noise budget for a depth log2(T) circuit")
+		}
+
+		require.NoError(t, tc.evaluator.IsZero(ciphertext, ciphertext))
+
+		want := make([]uint64, len(values))
+		for i := range want {
+			if values[i]%T == 0 {
+				want[i] = 1
+			}
+		}
+
+		verifyTestVectors(tc, tc.decryptor, want, ciphertext, t)
+	})
+
+	t.Run(GetTestName("Comparison/IsEqual", tc.params, lvl), func(t *testing.T) {
+
+		if tc.params.BasePlaintextModulusPower() != 1 || tc.params.LogPlaintextModulus() > 10 {
+			t.Skip("Skipping: the plaintext modulus is a prime power or too large")
+		}
+
+		values, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(1), tc, tc.encryptorSk)
+
+		// ct1 encrypts the same values as ct0, shifted by one in the odd slots
+		delta := make([]uint64, len(values))
+		for i := 1; i < len(delta); i += 2 {
+			delta[i] = 1
+		}
+
+		ct1, err := tc.evaluator.AddNew(ct0, delta)
+		require.NoError(t, err)
+
+		require.NoError(t, tc.evaluator.IsEqual(ct0, ct1, ct0))
+
+		want := make([]uint64, len(values))
+		for i := 0; i < len(want); i += 2 {
+			want[i] = 1
+		}
+
+		verifyTestVectors(tc, tc.decryptor, want, ct0, t)
+	})
+}
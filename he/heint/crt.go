@@ -0,0 +1,249 @@
+package heint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+)
+
+// CRTParameters is a list of [Parameters] sharing the same [rlwe.Parameters] but
+// with pairwise coprime plaintext moduli [T0, T1, T2, ...], enabling encrypted
+// modular arithmetic over the composite plaintext modulus T = T0 * T1 * T2 * ...,
+// which can exceed the 61-bit bound of a single plaintext modulus.
+//
+// A value mod T is stored as one ciphertext per Ti, each holding the residues of
+// the value mod Ti, and operations are applied component-wise (see [CRTEvaluator]).
+type CRTParameters []Parameters
+
+// NewCRTParameters instantiates a set of CRT parameters from the generic RLWE
+// parameters, the list of plaintext moduli Ts and the plaintext modulus power K.
+// The effective plaintext modulus of the i-th component is Ts[i]^K.
+// The moduli must be pairwise coprime and must all enable the same number of
+// slots, so that the components of a packed value remain slot-wise aligned.
+// It returns a non-nil error if the specified parameters are invalid.
+func NewCRTParameters(rlweParams rlwe.Parameters, Ts []uint64, K int) (p CRTParameters, err error) {
+
+	if len(Ts) == 0 {
+		return nil, fmt.Errorf("invalid parameters: empty list of plaintext moduli")
+	}
+
+	p = make(CRTParameters, len(Ts))
+	for i, T := range Ts {
+		if p[i], err = NewParameters(rlweParams, T, K); err != nil {
+			return nil, fmt.Errorf("invalid plaintext modulus Ts[%d]=%d: %w", i, T, err)
+		}
+	}
+
+	gcd := new(big.Int)
+	for i := range p {
+		for j := i + 1; j < len(p); j++ {
+			Ti := bignum.NewInt(p[i].PlaintextModulus())
+			Tj := bignum.NewInt(p[j].PlaintextModulus())
+			if gcd.GCD(nil, nil, Ti, Tj).Cmp(bignum.NewInt(1)) != 0 {
+				return nil, fmt.Errorf("invalid parameters: plaintext moduli Ts[%d]=%d and Ts[%d]=%d are not coprime", i, Ts[i], j, Ts[j])
+			}
+		}
+		if p[i].LogMaxSlots() != p[0].LogMaxSlots() {
+			return nil, fmt.Errorf("invalid parameters: plaintext modulus Ts[%d]=%d enables %d slots != %d enabled by Ts[0]=%d", i, Ts[i], p[i].MaxSlots(), p[0].MaxSlots(), Ts[0])
+		}
+	}
+
+	return
+}
+
+// PlaintextModulus returns the composite plaintext modulus T = T0 * T1 * T2 * ...
+func (p CRTParameters) PlaintextModulus() (T *big.Int) {
+	T = bignum.NewInt(1)
+	for i := range p {
+		T.Mul(T, bignum.NewInt(p[i].PlaintextModulus()))
+	}
+	return
+}
+
+// MaxSlots returns the total number of entries (`slots`) that a CRT plaintext can store.
+func (p CRTParameters) MaxSlots() int {
+	return p[0].MaxSlots()
+}
+
+// NewCRTPlaintext allocates a new CRT plaintext, i.e. one [rlwe.Plaintext] per component.
+func NewCRTPlaintext(params CRTParameters, level int) (pts []*rlwe.Plaintext) {
+	pts = make([]*rlwe.Plaintext, len(params))
+	for i := range pts {
+		pts[i] = NewPlaintext(params[i], level)
+	}
+	return
+}
+
+// NewCRTCiphertext allocates a new CRT ciphertext, i.e. one [rlwe.Ciphertext] per component.
+func NewCRTCiphertext(params CRTParameters, degree, level int) (cts []*rlwe.Ciphertext) {
+	cts = make([]*rlwe.Ciphertext, len(params))
+	for i := range cts {
+		cts[i] = NewCiphertext(params[i], degree, level)
+	}
+	return
+}
+
+// CRTEncoder encodes slices of [big.Int] on CRT plaintexts, storing on the i-th
+// component the residues of the values mod Ti via the component [Encoder].
+type CRTEncoder struct {
+	encoders []*Encoder
+	T        *big.Int
+	crt      []big.Int
+}
+
+// NewCRTEncoder instantiates a new CRTEncoder from the provided CRT parameters.
+func NewCRTEncoder(params CRTParameters) (ecd *CRTEncoder) {
+
+	ecd = &CRTEncoder{
+		encoders: make([]*Encoder, len(params)),
+		T:        params.PlaintextModulus(),
+		crt:      make([]big.Int, len(params)),
+	}
+
+	// crt[i] = (T/Ti) * ((T/Ti)^-1 mod Ti) mod T
+	tmp := new(big.Int)
+	for i := range params {
+		ecd.encoders[i] = NewEncoder(params[i])
+		Ti := bignum.NewInt(params[i].PlaintextModulus())
+		ecd.crt[i].Quo(ecd.T, Ti)
+		tmp.ModInverse(&ecd.crt[i], Ti)
+		ecd.crt[i].Mul(&ecd.crt[i], tmp)
+		ecd.crt[i].Mod(&ecd.crt[i], ecd.T)
+	}
+
+	return
+}
+
+// Encode encodes a slice of [big.Int] of size at most params.MaxSlots() on a
+// pre-allocated CRT plaintext (see [NewCRTPlaintext]).
+// Values are reduced mod T, thus can be provided either centered or in [0, T).
+func (ecd CRTEncoder) Encode(values []big.Int, pts []*rlwe.Plaintext) (err error) {
+
+	if len(pts) != len(ecd.encoders) {
+		return fmt.Errorf("cannot Encode: len(pts)=%d != %d components", len(pts), len(ecd.encoders))
+	}
+
+	residues := make([]uint64, len(values))
+	tmp := new(big.Int)
+
+	for i, encoder := range ecd.encoders {
+
+		Ti := bignum.NewInt(encoder.parameters.PlaintextModulus())
+
+		for j := range values {
+			residues[j] = tmp.Mod(&values[j], Ti).Uint64()
+		}
+
+		if err = encoder.Encode(residues, pts[i]); err != nil {
+			return fmt.Errorf("cannot Encode: component %d: %w", i, err)
+		}
+	}
+
+	return
+}
+
+// Decode decodes a CRT plaintext on a pre-allocated slice of [big.Int] of size at
+// most params.MaxSlots(), reconstructing the values mod T from the residues of the
+// components. Values are returned in [0, T).
+func (ecd CRTEncoder) Decode(pts []*rlwe.Plaintext, values []big.Int) (err error) {
+
+	if len(pts) != len(ecd.encoders) {
+		return fmt.Errorf("cannot Decode: len(pts)=%d != %d components", len(pts), len(ecd.encoders))
+	}
+
+	residues := make([]uint64, len(values))
+	tmp := new(big.Int)
+
+	for j := range values {
+		values[j].SetInt64(0)
+	}
+
+	for i, encoder := range ecd.encoders {
+
+		if err = encoder.Decode(pts[i], residues); err != nil {
+			return fmt.Errorf("cannot Decode: component %d: %w", i, err)
+		}
+
+		for j := range values {
+			tmp.Mul(&ecd.crt[i], bignum.NewInt(residues[j]))
+			values[j].Add(&values[j], tmp)
+		}
+	}
+
+	for j := range values {
+		values[j].Mod(&values[j], ecd.T)
+	}
+
+	return
+}
+
+// CRTEvaluator is a list of [Evaluator], one per component of a [CRTParameters],
+// applying the operations of the component evaluators component-wise on CRT
+// ciphertexts.
+type CRTEvaluator []*Evaluator
+
+// NewCRTEvaluator instantiates a new CRTEvaluator from the provided CRT parameters.
+// All components share the provided [rlwe.EvaluationKeySet].
+func NewCRTEvaluator(params CRTParameters, evk rlwe.EvaluationKeySet) (eval CRTEvaluator) {
+	eval = make(CRTEvaluator, len(params))
+	for i := range params {
+		eval[i] = NewEvaluator(params[i], evk)
+	}
+	return
+}
+
+// Add evaluates opOut = op0 + op1 component-wise.
+func (eval CRTEvaluator) Add(op0, op1, opOut []*rlwe.Ciphertext) (err error) {
+	if len(op0) != len(eval) || len(op1) != len(eval) || len(opOut) != len(eval) {
+		return fmt.Errorf("cannot Add: operands must have %d components", len(eval))
+	}
+	for i := range eval {
+		if err = eval[i].Add(op0[i], op1[i], opOut[i]); err != nil {
+			return fmt.Errorf("cannot Add: component %d: %w", i, err)
+		}
+	}
+	return
+}
+
+// Sub evaluates opOut = op0 - op1 component-wise.
+func (eval CRTEvaluator) Sub(op0, op1, opOut []*rlwe.Ciphertext) (err error) {
+	if len(op0) != len(eval) || len(op1) != len(eval) || len(opOut) != len(eval) {
+		return fmt.Errorf("cannot Sub: operands must have %d components", len(eval))
+	}
+	for i := range eval {
+		if err = eval[i].Sub(op0[i], op1[i], opOut[i]); err != nil {
+			return fmt.Errorf("cannot Sub: component %d: %w", i, err)
+		}
+	}
+	return
+}
+
+// Mul evaluates opOut = op0 * op1 component-wise, without relinearization.
+func (eval CRTEvaluator) Mul(op0, op1, opOut []*rlwe.Ciphertext) (err error) {
+	if len(op0) != len(eval) || len(op1) != len(eval) || len(opOut) != len(eval) {
+		return fmt.Errorf("cannot Mul: operands must have %d components", len(eval))
+	}
+	for i := range eval {
+		if err = eval[i].Mul(op0[i], op1[i], opOut[i]); err != nil {
+			return fmt.Errorf("cannot Mul: component %d: %w", i, err)
+		}
+	}
+	return
+}
+
+// MulRelin evaluates opOut = op0 * op1 component-wise, with relinearization.
+// The method requires the underlying [rlwe.EvaluationKeySet] to hold an
+// [rlwe.RelinearizationKey].
+func (eval CRTEvaluator) MulRelin(op0, op1, opOut []*rlwe.Ciphertext) (err error) {
+	if len(op0) != len(eval) || len(op1) != len(eval) || len(opOut) != len(eval) {
+		return fmt.Errorf("cannot MulRelin: operands must have %d components", len(eval))
+	}
+	for i := range eval {
+		if err = eval[i].MulRelin(op0[i], op1[i], opOut[i]); err != nil {
+			return fmt.Errorf("cannot MulRelin: component %d: %w", i, err)
+		}
+	}
+	return
+}
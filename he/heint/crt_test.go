@@ -0,0 +1,97 @@
+package heint_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/heint"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHEIntCRT(t *testing.T) {
+
+	// insecure parameters used for the sole purpose of fast testing.
+	rlweParams, err := rlwe.NewParametersFromLiteral(rlwe.ParametersLiteral{
+		LogN:    10,
+		LogQ:    []int{60, 60, 60, 60, 60},
+		LogP:    []int{61},
+		NTTFlag: heint.NTTFlag,
+	})
+	require.NoError(t, err)
+
+	// Pairwise coprime plaintext moduli enabling the same number of slots
+	Ts := []uint64{0x10001, 0xffc001}
+
+	params, err := heint.NewCRTParameters(rlweParams, Ts, 1)
+	require.NoError(t, err)
+
+	// Moduli that are not coprime must be rejected
+	_, err = heint.NewCRTParameters(rlweParams, []uint64{0x10001, 0x10001}, 1)
+	require.Error(t, err)
+
+	T := params.PlaintextModulus()
+
+	// The composite plaintext modulus must exceed each component
+	for i := range params {
+		require.Equal(t, 1, T.Cmp(bignum.NewInt(params[i].PlaintextModulus())))
+	}
+
+	kgen := rlwe.NewKeyGenerator(rlweParams)
+	sk := kgen.GenSecretKeyNew()
+	enc := rlwe.NewEncryptor(rlweParams, sk)
+	dec := rlwe.NewDecryptor(rlweParams, sk)
+	ecd := heint.NewCRTEncoder(params)
+	eval := heint.NewCRTEvaluator(params, rlwe.NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk)))
+
+	slots := params.MaxSlots()
+	level := rlweParams.MaxLevel()
+
+	source := sampling.NewSource(sampling.NewSeed())
+
+	// Values mod T, larger than any single plaintext modulus
+	a := make([]big.Int, slots)
+	b := make([]big.Int, slots)
+	for i := range a {
+		a[i] = *bignum.RandInt(source, T)
+		b[i] = *bignum.RandInt(source, T)
+	}
+
+	ptA := heint.NewCRTPlaintext(params, level)
+	ptB := heint.NewCRTPlaintext(params, level)
+
+	require.NoError(t, ecd.Encode(a, ptA))
+	require.NoError(t, ecd.Encode(b, ptB))
+
+	// Encode/Decode round-trip
+	have := make([]big.Int, slots)
+	require.NoError(t, ecd.Decode(ptA, have))
+	for i := range have {
+		require.Equal(t, 0, a[i].Cmp(&have[i]))
+	}
+
+	ctA := heint.NewCRTCiphertext(params, 1, level)
+	ctB := heint.NewCRTCiphertext(params, 1, level)
+
+	for i := range ctA {
+		require.NoError(t, enc.Encrypt(ptA[i], ctA[i]))
+		require.NoError(t, enc.Encrypt(ptB[i], ctB[i]))
+	}
+
+	require.NoError(t, eval.MulRelin(ctA, ctB, ctA))
+
+	for i := range ctA {
+		dec.Decrypt(ctA[i], ptA[i])
+	}
+
+	require.NoError(t, ecd.Decode(ptA, have))
+
+	want := new(big.Int)
+	for i := range have {
+		want.Mul(&a[i], &b[i])
+		want.Mod(want, T)
+		require.Equal(t, 0, want.Cmp(&have[i]))
+	}
+}
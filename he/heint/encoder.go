@@ -148,6 +148,21 @@ func (ecd Encoder) Encode(values IntegerSlice, pt *rlwe.Plaintext) (err error) {
 				ptT[i] = sign*(T-abs) | (sign^1)*abs
 			}
 
+			valLen = len(values)
+		case []int32:
+
+			if len(values) > N {
+				return fmt.Errorf("cannot Encode (IsBatched = false): len(values)=%d > N=%d", len(values), N)
+			}
+
+			var sign, abs uint64
+			for i, c := range values {
+				c64 := int64(c)
+				sign = uint64(c64) >> 63
+				abs = ring.BRedAdd(uint64(c64*((int64(sign)^1)-int64(sign))), T, BRC)
+				ptT[i] = sign*(T-abs) | (sign^1)*abs
+			}
+
 			valLen = len(values)
 		}
 
@@ -166,6 +181,68 @@ func (ecd Encoder) Encode(values IntegerSlice, pt *rlwe.Plaintext) (err error) {
 	}
 }
 
+// EncodePlain encodes a slice of coefficients on a pre-allocated plaintext, mapping values[i]
+// mod PlaintextModulus directly on the i-th coefficient of the plaintext polynomial.
+// Unlike Encode, no SIMD slot mapping, NTT or Montgomery transform is applied, and the
+// plaintext metadata (IsBatched, IsNTT, IsMontgomery) are set to false accordingly.
+// This is a non-optimized debug path, intended to construct known plaintext polynomials,
+// e.g. to verify the NTT-based encoding and polynomial arithmetic against a plain reference.
+func (ecd Encoder) EncodePlain(values []uint64, pt *rlwe.Plaintext) (err error) {
+
+	rT := ecd.parameters.RT
+	N := ecd.parameters.N()
+
+	if len(values) > N {
+		return fmt.Errorf("cannot EncodePlain: len(values)=%d > N=%d", len(values), N)
+	}
+
+	pt.IsBatched = false
+	pt.IsNTT = false
+	pt.IsMontgomery = false
+
+	T := rT.Modulus
+	BRC := rT.BRedConstant
+
+	ptT := ecd.bufQ.At(0)
+
+	for i, c := range values {
+		ptT[i] = ring.BRedAdd(c, T, BRC)
+	}
+
+	for i := len(values); i < N; i++ {
+		ptT[i] = 0
+	}
+
+	rT.MulScalar(ptT, pt.Scale.Uint64(), ptT)
+	ecd.RingT2Q(pt.Level(), true, ptT, pt.Q)
+
+	return
+}
+
+// DecodePlain decodes a plaintext on a slice of coefficients mod PlaintextModulus,
+// mapping the i-th coefficient of the plaintext polynomial on values[i].
+// It is the inverse of EncodePlain: no SIMD slot mapping is applied.
+// If the plaintext is in the NTT domain, it is first mapped back to the coefficient domain.
+// This is a non-optimized debug path, see EncodePlain for additional information.
+func (ecd Encoder) DecodePlain(pt *rlwe.Plaintext, values []uint64) (err error) {
+
+	pT := ecd.bufQ.At(0)
+
+	if pt.IsNTT {
+		ecd.parameters.RingQ().AtLevel(pt.Level()).INTT(pt.Q, ecd.bufQ)
+		ecd.RingQ2T(pt.Level(), true, ecd.bufQ, pT)
+	} else {
+		ecd.RingQ2T(pt.Level(), true, pt.Q, pT)
+	}
+
+	rT := ecd.parameters.RT
+	rT.MulScalar(pT, ring.ModExp(pt.Scale.Uint64(), rT.Phi()-1, rT.Modulus), pT)
+
+	copy(values, pT)
+
+	return
+}
+
 // EncodeRingT encodes an IntegerSlice at the given scale on a polynomial pT with coefficients modulo the plaintext modulus.
 func (ecd Encoder) EncodeRingT(values IntegerSlice, scale rlwe.Scale, pT []uint64) (err error) {
 	perm := ecd.indexMatrix
@@ -206,9 +283,28 @@ func (ecd Encoder) EncodeRingT(values IntegerSlice, scale rlwe.Scale, pT []uint6
 			pT[perm[i]] = sign*(T-abs) | (sign^1)*abs
 		}
 
+		valLen = len(values)
+
+	case []int32:
+
+		if len(values) > slots {
+			return fmt.Errorf("cannot Encode (IsBatched = true): len(values)=%d > slots=%d", len(values), slots)
+		}
+
+		T := rT.Modulus
+		BRC := rT.BRedConstant
+
+		var sign, abs uint64
+		for i, c := range values {
+			c64 := int64(c)
+			sign = uint64(c64) >> 63
+			abs = ring.BRedAdd(uint64(c64*((int64(sign)^1)-int64(sign))), T, BRC)
+			pT[perm[i]] = sign*(T-abs) | (sign^1)*abs
+		}
+
 		valLen = len(values)
 	default:
-		return fmt.Errorf("values.(type) must be either []uint64 or []int64 but is %T", values)
+		return fmt.Errorf("values.(type) must be either []uint64, []int64 or []int32 but is %T", values)
 	}
 
 	// Zeroes the non-mapped coefficients
@@ -323,8 +419,20 @@ func (ecd Encoder) DecodeRingT(pT []uint64, scale rlwe.Scale, values IntegerSlic
 				values[i] = value
 			}
 		}
+	case []int32:
+		// The user must ensure that the centered values fit in an int32,
+		// the higher bits are truncated otherwise.
+		modulus := int64(ecd.parameters.PlaintextModulus())
+		modulusHalf := modulus >> 1
+		var value int64
+		for i := range values {
+			if value = int64(pT[ecd.indexMatrix[i]]); value >= modulusHalf {
+				value -= modulus
+			}
+			values[i] = int32(value)
+		}
 	default:
-		return fmt.Errorf("cannot DecodeRingT: values must be either []uint64 or []int64 but is %T", values)
+		return fmt.Errorf("cannot DecodeRingT: values must be either []uint64, []int64 or []int32 but is %T", values)
 	}
 
 	return
@@ -464,8 +572,25 @@ func (ecd Encoder) Decode(pt *rlwe.Plaintext, values IntegerSlice) (err error) {
 				}
 			}
 
+		case []int32:
+
+			// The user must ensure that the centered values fit in an int32,
+			// the higher bits are truncated otherwise.
+			rT := ecd.parameters.RT
+			N := ecd.parameters.N()
+			modulus := int64(rT.Modulus)
+			modulusHalf := modulus >> 1
+
+			var value int64
+			for i := 0; i < N; i++ {
+				if value = int64(pT[i]); value >= modulusHalf {
+					value -= modulus
+				}
+				values[i] = int32(value)
+			}
+
 		default:
-			return fmt.Errorf("cannot Decode: values must be either []uint64 or []int64 but is %T", values)
+			return fmt.Errorf("cannot Decode: values must be either []uint64, []int64 or []int32 but is %T", values)
 		}
 
 		return
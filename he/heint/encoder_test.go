@@ -39,6 +39,28 @@ func testEncoder(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Encoder/Int32/IsBatched=true", tc.params, lvl), func(t *testing.T) {
+
+			T := tc.params.PlaintextModulus()
+			THalf := min(T>>1, uint64(1<<31))
+			coeffs := make([]int32, tc.params.MaxSlots())
+			for i := range coeffs {
+				if i > int(THalf) {
+					coeffs[i] = int32(-i)
+				} else {
+					coeffs[i] = int32(i)
+				}
+			}
+
+			plaintext := heint.NewPlaintext(tc.params, lvl)
+			require.NoError(t, tc.encoder.Encode(coeffs, plaintext))
+			have := make([]int32, tc.params.MaxSlots())
+			require.NoError(t, tc.encoder.Decode(plaintext, have))
+			require.True(t, slices.Equal(coeffs, have))
+		})
+	}
+
 	for _, lvl := range tc.testLevel {
 		t.Run(GetTestName("Encoder/Uint/IsBatched=false", tc.params, lvl), func(t *testing.T) {
 			coeffs := make([]uint64, tc.params.N())
@@ -56,6 +78,33 @@ func testEncoder(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Encoder/Uint/Plain", tc.params, lvl), func(t *testing.T) {
+			coeffs := make([]uint64, tc.params.N())
+			T := tc.params.PlaintextModulus()
+			for i := range coeffs {
+				coeffs[i] = uint64(i) % T
+			}
+
+			plaintext := heint.NewPlaintext(tc.params, lvl)
+			require.NoError(t, tc.encoder.EncodePlain(coeffs, plaintext))
+			require.False(t, plaintext.IsBatched)
+			require.False(t, plaintext.IsNTT)
+			require.False(t, plaintext.IsMontgomery)
+
+			have := make([]uint64, tc.params.N())
+			require.NoError(t, tc.encoder.DecodePlain(plaintext, have))
+			require.True(t, slices.Equal(coeffs, have))
+
+			// The NTT-based coefficient encoding must agree with the plain reference
+			ref := heint.NewPlaintext(tc.params, lvl)
+			ref.IsBatched = false
+			require.NoError(t, tc.encoder.Encode(coeffs, ref))
+			require.NoError(t, tc.encoder.DecodePlain(ref, have))
+			require.True(t, slices.Equal(coeffs, have))
+		})
+	}
+
 	for _, lvl := range tc.testLevel {
 		t.Run(GetTestName("Encoder/Int/IsBatched=false", tc.params, lvl), func(t *testing.T) {
 
@@ -78,4 +127,27 @@ func testEncoder(tc *testContext, t *testing.T) {
 			require.True(t, slices.Equal(coeffs, have))
 		})
 	}
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Encoder/Int32/IsBatched=false", tc.params, lvl), func(t *testing.T) {
+
+			T := int64(tc.params.PlaintextModulus())
+			THalf := T >> 1
+			coeffs := make([]int32, tc.params.N())
+			for i := range coeffs {
+				c := int64(i) % T
+				if c >= THalf {
+					coeffs[i] = int32(c - T)
+				} else {
+					coeffs[i] = int32(c)
+				}
+			}
+			plaintext := heint.NewPlaintext(tc.params, lvl)
+			plaintext.IsBatched = false
+			require.NoError(t, tc.encoder.Encode(coeffs, plaintext))
+			have := make([]int32, tc.params.N())
+			require.NoError(t, tc.encoder.Decode(plaintext, have))
+			require.True(t, slices.Equal(coeffs, have))
+		})
+	}
 }
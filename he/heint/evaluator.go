@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sync"
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -17,76 +18,107 @@ type Evaluator struct {
 	*evaluatorBuffers
 	*rlwe.Evaluator
 	*Encoder
+
+	// strictScales, if set, makes Add and Sub return an error on a scale
+	// mismatch instead of transparently matching the scales.
+	// See [Evaluator.WithStrictScales].
+	strictScales bool
 }
 
 type evaluatorBase struct {
-	tMontgomery   ring.RNSScalar
-	levelQMul     []int      // optimal #QiMul depending on #Qi (variable level)
-	pHalf         []*big.Int // all prod(QiMul) / 2 depending on #Qi
+	// tMontgomery is eagerly computed since it is also
+	// required by the standard tensoring.
+	tMontgomery ring.RNSScalar
+
+	// qMul is shared across shallow copies and lazily initialized
+	// at the first scale-invariant tensoring.
+	// See [Evaluator.initScaleInvariant].
+	qMul *qMulPrecomp
+
+	// buffModUpQMul is local to the receiver and lazily allocated
+	// together with the qMul precomputations.
 	buffModUpQMul ring.RNSPoly
 }
 
+// qMulPrecomp holds the precomputations required by the scale-invariant (BFV-style)
+// tensoring. They are lazily initialized at the first scale-invariant multiplication
+// (see [Evaluator.initScaleInvariant]) so that evaluators that only perform the
+// standard tensoring do not pay their construction cost, and are shared across
+// shallow copies of the Evaluator.
+type qMulPrecomp struct {
+	sync.Once
+	levelQMul []int      // optimal #QiMul depending on #Qi (variable level)
+	pHalf     []*big.Int // all prod(QiMul) / 2 depending on #Qi
+}
+
 func (eval evaluatorBase) ShallowCopy() *evaluatorBase {
 	return &evaluatorBase{
-		tMontgomery:   eval.tMontgomery,
-		levelQMul:     eval.levelQMul,
-		pHalf:         eval.pHalf,
-		buffModUpQMul: *eval.buffModUpQMul.Clone(),
+		tMontgomery: eval.tMontgomery,
+		qMul:        eval.qMul,
 	}
 }
 
 func newEvaluatorPrecomp(parameters Parameters) *evaluatorBase {
 	rQ := parameters.RingQ()
-	rQMul := parameters.RQMul
 	t := parameters.PlaintextModulus()
 
-	levelQMul := make([]int, rQ.ModuliChainLength())
-	Q := new(big.Int).SetUint64(1)
-	for i := range levelQMul {
-		Q.Mul(Q, new(big.Int).SetUint64(rQ[i].Modulus))
-		levelQMul[i] = int(math.Ceil(float64(Q.BitLen()+parameters.LogN())/61.0)) - 1
-	}
-
-	pHalf := make([]*big.Int, rQMul.ModuliChainLength())
-
-	QMul := new(big.Int).SetUint64(1)
-	for i := range pHalf {
-		QMul.Mul(QMul, new(big.Int).SetUint64(rQMul[i].Modulus))
-		pHalf[i] = new(big.Int).Rsh(QMul, 1)
-	}
-
 	// t * 2^{64} mod Q
 	tMontgomery := rQ.NewRNSScalarFromBigint(new(big.Int).Lsh(new(big.Int).SetUint64(t), 64))
 	rQ.MFormRNSScalar(tMontgomery, tMontgomery)
 
 	return &evaluatorBase{
-		tMontgomery:   tMontgomery,
-		levelQMul:     levelQMul,
-		pHalf:         pHalf,
-		buffModUpQMul: rQMul.NewRNSPoly(),
+		tMontgomery: tMontgomery,
+		qMul:        &qMulPrecomp{},
 	}
 }
 
 type evaluatorBuffers struct {
+	// buffQMul holds the buffers of the scale-invariant tensoring,
+	// lazily allocated at the first use.
+	// See [Evaluator.initScaleInvariant].
 	buffQMul [7]ring.RNSPoly
 }
 
-func newEvaluatorBuffer(params Parameters) *evaluatorBuffers {
+// initScaleInvariant lazily performs the precomputations and buffer allocations
+// required by the scale-invariant tensoring. The precomputations are shared
+// across shallow copies of the receiver and their initialization is
+// concurrent-safe; the buffers are local to the receiver.
+func (eval Evaluator) initScaleInvariant() {
 
-	rQMul := params.RQMul
+	parameters := eval.GetParameters()
 
-	buffQMul := [7]ring.RNSPoly{
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
-		rQMul.NewRNSPoly(),
+	rQ := parameters.RingQ()
+	rQMul := parameters.RQMul
+
+	eval.qMul.Do(func() {
+
+		levelQMul := make([]int, rQ.ModuliChainLength())
+		Q := new(big.Int).SetUint64(1)
+		for i := range levelQMul {
+			Q.Mul(Q, new(big.Int).SetUint64(rQ[i].Modulus))
+			levelQMul[i] = int(math.Ceil(float64(Q.BitLen()+parameters.LogN())/61.0)) - 1
+		}
+
+		pHalf := make([]*big.Int, rQMul.ModuliChainLength())
+
+		QMul := new(big.Int).SetUint64(1)
+		for i := range pHalf {
+			QMul.Mul(QMul, new(big.Int).SetUint64(rQMul[i].Modulus))
+			pHalf[i] = new(big.Int).Rsh(QMul, 1)
+		}
+
+		eval.qMul.levelQMul = levelQMul
+		eval.qMul.pHalf = pHalf
+	})
+
+	if eval.buffModUpQMul == nil {
+		eval.evaluatorBase.buffModUpQMul = rQMul.NewRNSPoly()
 	}
 
-	return &evaluatorBuffers{
-		buffQMul: buffQMul,
+	if eval.buffQMul[0] == nil {
+		for i := range eval.buffQMul {
+			eval.evaluatorBuffers.buffQMul[i] = rQMul.NewRNSPoly()
+		}
 	}
 }
 
@@ -96,7 +128,7 @@ func newEvaluatorBuffer(params Parameters) *evaluatorBuffers {
 func NewEvaluator(parameters Parameters, evk rlwe.EvaluationKeySet) *Evaluator {
 	ev := new(Evaluator)
 	ev.evaluatorBase = newEvaluatorPrecomp(parameters)
-	ev.evaluatorBuffers = newEvaluatorBuffer(parameters)
+	ev.evaluatorBuffers = new(evaluatorBuffers)
 	ev.Evaluator = rlwe.NewEvaluator(parameters.Parameters, evk)
 	ev.Encoder = NewEncoder(parameters)
 
@@ -114,8 +146,9 @@ func (eval Evaluator) ShallowCopy() *Evaluator {
 	return &Evaluator{
 		evaluatorBase:    eval.evaluatorBase.ShallowCopy(),
 		Evaluator:        eval.Evaluator.ShallowCopy(),
-		evaluatorBuffers: newEvaluatorBuffer(*eval.GetParameters()),
+		evaluatorBuffers: new(evaluatorBuffers),
 		Encoder:          eval.Encoder.ShallowCopy(),
+		strictScales:     eval.strictScales,
 	}
 }
 
@@ -127,6 +160,24 @@ func (eval Evaluator) WithKey(evk rlwe.EvaluationKeySet) *Evaluator {
 		Evaluator:        eval.Evaluator.WithKey(evk),
 		evaluatorBuffers: eval.evaluatorBuffers,
 		Encoder:          eval.Encoder,
+		strictScales:     eval.strictScales,
+	}
+}
+
+// WithStrictScales creates a shallow copy of this Evaluator, sharing all the read-only
+// data-structures and buffers with the receiver, whose Add and Sub return an error on a
+// scale mismatch between the operands instead of transparently matching the scales.
+// The automatic scale matching increases the noise by a small factor, silently: for
+// performance-critical circuits where the scales are guaranteed to match by construction,
+// this variant surfaces accidental scale drift as a hard failure during development.
+// The receiver and the returned Evaluator cannot be used concurrently.
+func (eval Evaluator) WithStrictScales() *Evaluator {
+	return &Evaluator{
+		evaluatorBase:    eval.evaluatorBase,
+		Evaluator:        eval.Evaluator,
+		evaluatorBuffers: eval.evaluatorBuffers,
+		Encoder:          eval.Encoder,
+		strictScales:     true,
 	}
 }
 
@@ -135,6 +186,24 @@ func (eval Evaluator) LevelsConsumedPerRescaling() int {
 	return 1
 }
 
+// checkNTTDomain verifies that the operands that are an [rlwe.Element] are in the NTT domain
+// expected by the parameters, returning an explicit error if one of them is not, instead of
+// letting the operation silently compute garbage.
+func (eval Evaluator) checkNTTDomain(ops ...rlwe.Operand) (err error) {
+	NTTFlag := eval.parameters.NTTFlag()
+	for _, op := range ops {
+		if el, ok := op.(rlwe.Element); ok {
+			if ct := el.AsCiphertext(); ct.MetaData != nil && ct.IsNTT != NTTFlag {
+				if NTTFlag {
+					return fmt.Errorf("expected NTT-domain ciphertext, got coefficient domain")
+				}
+				return fmt.Errorf("expected coefficient-domain ciphertext, got NTT domain")
+			}
+		}
+	}
+	return
+}
+
 // Add adds op1 to op0 and returns the result in op2.
 // The following types are accepted for op1:
 //   - rlwe.Element
@@ -145,7 +214,11 @@ func (eval Evaluator) LevelsConsumedPerRescaling() int {
 // be automatically carried out to ensure that addition is performed between operands of the same scale.
 // This scale matching operation will increase the noise by a small factor.
 // For this reason it is preferable to ensure that all operands are already at the same scale when calling this method.
+// See [Evaluator.WithStrictScales] for a variant of the evaluator that returns an error on a scale mismatch instead.
 func (eval Evaluator) Add(op0 *rlwe.Ciphertext, op1 rlwe.Operand, op2 *rlwe.Ciphertext) (err error) {
+	if err = eval.checkNTTDomain(op0, op1); err != nil {
+		return fmt.Errorf("cannot Add: %w", err)
+	}
 	return eval.addition(op0, op1, op2, true)
 }
 
@@ -181,6 +254,7 @@ func (eval Evaluator) AddNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (op2 *rlwe.
 // be automatically carried out to ensure that the subtraction is performed between operands of the same scale.
 // This scale matching operation will increase the noise by a small factor.
 // For this reason it is preferable to ensure that all operands are already at the same scale when calling this method.
+// See [Evaluator.WithStrictScales] for a variant of the evaluator that returns an error on a scale mismatch instead.
 func (eval Evaluator) Sub(op0 *rlwe.Ciphertext, op1 rlwe.Operand, op2 *rlwe.Ciphertext) (err error) {
 	return eval.addition(op0, op1, op2, false)
 }
@@ -223,6 +297,10 @@ func (eval Evaluator) addition(op0 *rlwe.Ciphertext, op1 rlwe.Operand, op2 *rlwe
 		op2.ResizeQ(level)
 		op2.ResizeDegree(degree)
 
+		if op0.Scale.Cmp(el1.Scale) != 0 && eval.strictScales {
+			return fmt.Errorf("cannot Add: %w: op0.Scale=%d != op1.Scale=%d and the evaluator enforces strict scales (see [Evaluator.WithStrictScales])", rlwe.ErrScaleMismatch, op0.Scale.Uint64(), el1.Scale.Uint64())
+		}
+
 		if positive {
 			if op0.Scale.Cmp(el1.Scale) == 0 {
 				eval.evaluateInPlace(level, op0, el1, op2, rQ.AtLevel(level).Add)
@@ -382,6 +460,10 @@ func (eval Evaluator) DropLevel(op0 *rlwe.Ciphertext, levels int) {
 //   - the scale of op2 will be updated to op0.Scale * op1.Scale
 func (eval Evaluator) Mul(op0 *rlwe.Ciphertext, op1 rlwe.Operand, op2 *rlwe.Ciphertext) (err error) {
 
+	if err = eval.checkNTTDomain(op0, op1); err != nil {
+		return fmt.Errorf("cannot Mul: %w", err)
+	}
+
 	switch op1 := op1.(type) {
 	case rlwe.Element:
 
@@ -492,6 +574,70 @@ func (eval Evaluator) MulNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (op2 *rlwe.
 	return op2, eval.Mul(op0, op1, op2)
 }
 
+// AffineScalar evaluates op2 = mul * op0 + add in a single pass over the coefficients,
+// which is cheaper than a scalar [Evaluator.Mul] followed by a scalar [Evaluator.Add].
+// Such affine transforms are common when evaluating quantized circuits (e.g. the
+// re-quantization step of a quantized neural network layer).
+// Both mul and add must be reduced modulo the [PlaintextModulus], else the procedure
+// returns an error.
+// The scale of op2 is set to the scale of op0 and no level is consumed.
+func (eval Evaluator) AffineScalar(op0 *rlwe.Ciphertext, mul, add uint64, op2 *rlwe.Ciphertext) (err error) {
+
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot AffineScalar: %w", err)
+	}
+
+	T := eval.parameters.RT.Modulus
+
+	if mul >= T {
+		return fmt.Errorf("cannot AffineScalar: mul=%d is not reduced modulo the PlaintextModulus=%d", mul, T)
+	}
+
+	if add >= T {
+		return fmt.Errorf("cannot AffineScalar: add=%d is not reduced modulo the PlaintextModulus=%d", add, T)
+	}
+
+	_, level, err := eval.InitOutputUnaryOp(op0, op2)
+	if err != nil {
+		return fmt.Errorf("cannot AffineScalar: %w", err)
+	}
+
+	op2.ResizeQ(level)
+	op2.ResizeDegree(op0.Degree())
+
+	rQ := eval.parameters.RingQ().AtLevel(level)
+
+	TBig := new(big.Int).SetUint64(T)
+	THalf := new(big.Int).Rsh(TBig, 1)
+
+	// If mul > T/2 then subtract T to minimize the noise
+	mulBig := new(big.Int).SetUint64(mul)
+	if mulBig.Cmp(THalf) == 1 {
+		mulBig.Sub(mulBig, TBig)
+	}
+
+	// Sets add to the scale of op0
+	addBig := new(big.Int).SetUint64(add)
+	addBig.Mul(addBig, new(big.Int).SetUint64(op0.Scale.Uint64()))
+	addBig.Mod(addBig, TBig)
+
+	// If add > T/2 -> add -= T
+	if addBig.Cmp(THalf) == 1 {
+		addBig.Sub(addBig, TBig)
+	}
+
+	// Scales add by T^{-1} mod Q
+	addBig.Mul(addBig, eval.tInvModQ[level])
+
+	rQ.MulScalarThenAddScalarBigint(op0.Q[0], addBig, mulBig, op2.Q[0])
+
+	for i := 1; i < op0.Degree()+1; i++ {
+		rQ.MulScalarBigint(op0.Q[i], mulBig, op2.Q[i])
+	}
+
+	return
+}
+
 // MulRelin multiplies op0 with op1 with relinearization and using standard tensoring (BGV/CKKS-style), and returns the result in op2.
 // This tensoring increases the noise by a multiplicative factor of the plaintext and noise norms of the operands and will usually
 // require to be followed by a rescaling operation to avoid an exponential growth of the noise from subsequent multiplications.
@@ -558,6 +704,93 @@ func (eval Evaluator) MulRelinNew(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (op2 *
 	return op2, eval.MulRelin(op0, op1, op2)
 }
 
+// Square computes the square of op0 with relinearization and using standard tensoring
+// (BGV/CKKS-style), and returns the result in op2. It is a shorthand for
+// MulRelin(op0, op0, op2) guaranteeing the dedicated squaring code path of the tensoring,
+// which spares one of the base multiplications of a generic product.
+// The scale of op2 will be to op0.Scale^2.
+func (eval Evaluator) Square(op0, op2 *rlwe.Ciphertext) (err error) {
+	return eval.MulRelin(op0, op0, op2)
+}
+
+// SquareNew computes the square of op0 with relinearization and using standard tensoring
+// (BGV/CKKS-style), and returns the result in a new *rlwe.Ciphertext op2.
+// See [Evaluator.Square].
+func (eval Evaluator) SquareNew(op0 *rlwe.Ciphertext) (op2 *rlwe.Ciphertext, err error) {
+	return eval.MulRelinNew(op0, op0)
+}
+
+// SquareThenAdd computes the square of op0 with relinearization and using standard
+// tensoring (BGV/CKKS-style), and adds the result to op2, through the dedicated squaring
+// code path of the tensoring. It is useful for sum-of-squares circuits.
+// See [Evaluator.MulRelinThenAdd] for the requirements on the operands.
+func (eval Evaluator) SquareThenAdd(op0, op2 *rlwe.Ciphertext) (err error) {
+	return eval.MulRelinThenAdd(op0, op0, op2)
+}
+
+// Mask zeroes all the slots of op0 outside of the range [from, to) and writes the result on op2.
+// The 0/1 mask is multiplied as a plaintext encoded at scale 1, thus the scale and the level of
+// op2 are the ones of op0 and no relinearization is needed, making the method cheaper than a
+// generic plaintext product for this common, level-sensitive primitive.
+func (eval Evaluator) Mask(op0 *rlwe.Ciphertext, from, to int, op2 *rlwe.Ciphertext) (err error) {
+
+	slots := eval.parameters.MaxSlots()
+
+	if from < 0 || to < from || to > slots {
+		return fmt.Errorf("cannot Mask: invalid range [%d, %d): must satisfy 0 <= from <= to <= %d", from, to, slots)
+	}
+
+	mask := make([]uint64, slots)
+	for i := from; i < to; i++ {
+		mask[i] = 1
+	}
+
+	if err = eval.Mul(op0, mask, op2); err != nil {
+		return fmt.Errorf("cannot Mask: %w", err)
+	}
+
+	return
+}
+
+// MulRelinRescale multiplies op0 with op1 with relinearization and using standard tensoring (BGV/CKKS-style),
+// rescales the result by the last prime of the moduli chain and returns it in op2.
+// It is a shorthand for [Evaluator.MulRelin] followed by [Evaluator.Rescale], which is the usual
+// sequence in deep BGV circuits to avoid an exponential growth of the noise from subsequent multiplications.
+// The procedure will return an error if either op0.Degree or op1.Degree > 1.
+// The procedure will return an error if op2.Degree != op0.Degree + op1.Degree.
+// The procedure will return an error if the evaluator was not created with an relinearization key.
+// The procedure will return an error if there is no level left to consume for the rescaling.
+//
+// The following types are accepted for op1:
+//   - [rlwe.Element]
+//   - *big.Int, uint64, int64, int
+//   - []uint64 or []int64 (of size at most N where N is the smallest integer satisfying PlaintextModulus = 1 mod 2N)
+//
+// The level of op2 will be updated to min(op0.Level(), op1.Level()) - 1 and its scale to
+// op0.Scale * op1.Scale * qi^{-1} mod PlaintextModulus where qi is the prime consumed by
+// the rescaling operation.
+func (eval Evaluator) MulRelinRescale(op0 *rlwe.Ciphertext, op1 rlwe.Operand, op2 *rlwe.Ciphertext) (err error) {
+
+	level := op0.Level()
+	if el, ok := op1.(rlwe.Element); ok {
+		level = min(level, el.AsCiphertext().Level())
+	}
+
+	if level == 0 {
+		return fmt.Errorf("cannot MulRelinRescale: %w: no level left to consume for the rescaling", rlwe.ErrInsufficientLevel)
+	}
+
+	if err = eval.MulRelin(op0, op1, op2); err != nil {
+		return fmt.Errorf("cannot MulRelinRescale: %w", err)
+	}
+
+	if err = eval.Rescale(op2, op2); err != nil {
+		return fmt.Errorf("cannot MulRelinRescale: %w", err)
+	}
+
+	return
+}
+
 func (eval Evaluator) tensorStandardDegreeTwo(LevelQ int, op0, op1, op2 *rlwe.Ciphertext, c2 ring.RNSPoly) {
 
 	rQ := eval.parameters.RingQ().AtLevel(LevelQ)
@@ -873,9 +1106,11 @@ func (eval Evaluator) MulRelinScaleInvariantNew(op0 *rlwe.Ciphertext, op1 rlwe.O
 // tensorScaleInvariant computes (op0 x op1) * (t/Q) and stores the result in op2.
 func (eval Evaluator) tensorScaleInvariant(op0, op1 *rlwe.Ciphertext, relin bool, op2 *rlwe.Ciphertext) (err error) {
 
+	eval.initScaleInvariant()
+
 	level := min(min(op0.Level(), op1.Level()), op2.Level())
 
-	levelQMul := eval.levelQMul[level]
+	levelQMul := eval.qMul.levelQMul[level]
 
 	// Avoid overwriting if the second input is the output
 	var tmp0Q0, tmp1Q0 *rlwe.Ciphertext
@@ -1298,8 +1533,12 @@ func (eval Evaluator) Rescale(op0, op2 *rlwe.Ciphertext) (err error) {
 		return fmt.Errorf("cannot Rescale: op0.MetaData or op2.MetaData is nil")
 	}
 
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot Rescale: %w", err)
+	}
+
 	if op0.Level() == 0 {
-		return fmt.Errorf("cannot rescale: op0 already at level 0")
+		return fmt.Errorf("cannot rescale: %w: op0 already at level 0", rlwe.ErrInsufficientLevel)
 	}
 
 	if op2.Level() < op0.Level()-1 {
@@ -1320,6 +1559,47 @@ func (eval Evaluator) Rescale(op0, op2 *rlwe.Ciphertext) (err error) {
 	return
 }
 
+// ModSwitchDown switches op0 in place from its current modulus Q[:op0.Level()+1] to the smaller
+// modulus Q[:op0.Level()-nLevels+1], dropping nLevels primes of the moduli chain while preserving
+// the encrypted plaintext: the scale of op0 is updated to op0.Scale * prod(qi)^{-1} mod PlaintextModulus
+// where the qi are the primes consumed by the switching, so the decryption is left unchanged.
+// This is the procedure of choice to shrink ciphertexts before storage or transmission, as opposed
+// to [Evaluator.DropLevel] which truncates the ciphertext (and thus changes its decryption).
+// The procedure will return an error if nLevels < 0 or op0.Level() < nLevels.
+func (eval Evaluator) ModSwitchDown(op0 *rlwe.Ciphertext, nLevels int) (err error) {
+
+	if op0.MetaData == nil {
+		return fmt.Errorf("cannot ModSwitchDown: op0.MetaData is nil")
+	}
+
+	if nLevels < 0 {
+		return fmt.Errorf("cannot ModSwitchDown: nLevels=%d < 0", nLevels)
+	}
+
+	if op0.Level() < nLevels {
+		return fmt.Errorf("cannot ModSwitchDown: op0.Level()=%d < nLevels=%d", op0.Level(), nLevels)
+	}
+
+	if nLevels == 0 {
+		return
+	}
+
+	level := op0.Level()
+	rQ := eval.parameters.RingQ().AtLevel(level)
+
+	for i := range op0.Q {
+		rQ.DivRoundByLastModulusManyNTT(nLevels, op0.Q[i], eval.BuffQ[0], op0.Q[i])
+	}
+
+	op0.ResizeQ(level - nLevels)
+
+	for i := range nLevels {
+		op0.Scale = op0.Scale.Div(eval.parameters.NewScale(rQ[level-i].Modulus))
+	}
+
+	return
+}
+
 // RelinearizeNew applies the relinearization procedure on op0 and returns the result in a new op1.
 func (eval Evaluator) RelinearizeNew(op0 *rlwe.Ciphertext) (op1 *rlwe.Ciphertext, err error) {
 	op1 = NewCiphertext(eval.parameters, 1, op0.Level())
@@ -1347,9 +1627,26 @@ func (eval Evaluator) RotateColumnsNew(op0 *rlwe.Ciphertext, k int) (op1 *rlwe.C
 // The procedure will return an error if the corresponding Galois key has not been generated and attributed to the evaluator.
 // The procedure will return an error if either op0.Degree() or op1.Degree() != 1.
 func (eval Evaluator) RotateColumns(op0 *rlwe.Ciphertext, k int, op1 *rlwe.Ciphertext) (err error) {
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot RotateColumns: %w", err)
+	}
 	return eval.Automorphism(op0, eval.parameters.GaloisElement(k), op1)
 }
 
+// InnerSumLog evaluates [rlwe.Evaluator.InnerSum] on op0 after validating, up front,
+// that all the Galois keys required for the parameters batch and n are present in the
+// EvaluationKeySet. If keys are missing, the method returns before any rotation is
+// performed an error wrapping [rlwe.ErrMissingGaloisKey] that lists all the missing
+// Galois elements, instead of failing in the middle of the evaluation.
+// The required Galois elements can be generated with the elements returned by
+// [Parameters.GaloisElementsForInnerSum] with the same batch and n.
+func (eval Evaluator) InnerSumLog(op0 *rlwe.Ciphertext, batch, n int, buf rlwe.HoistingBuffer, op1 *rlwe.Ciphertext) (err error) {
+	if missing := eval.MissingGaloisKeys(eval.parameters.GaloisElementsForInnerSum(batch, n)); len(missing) > 0 {
+		return fmt.Errorf("cannot InnerSumLog: %w: GaloisKeys for galEls %v are not available in the EvaluationKeySet", rlwe.ErrMissingGaloisKey, missing)
+	}
+	return eval.InnerSum(op0, batch, n, buf, op1)
+}
+
 // RotateRowsNew swaps the rows of op0 and returns the result in a new op1.
 // The procedure will return an error if the corresponding Galois key has not been generated and attributed to the evaluator.
 // The procedure will return an error if op0.Degree() != 1.
@@ -1362,9 +1659,173 @@ func (eval Evaluator) RotateRowsNew(op0 *rlwe.Ciphertext) (op1 *rlwe.Ciphertext,
 // The procedure will return an error if the corresponding Galois key has not been generated and attributed to the evaluator.
 // The procedure will return an error if either op0.Degree() or op1.Degree() != 1.
 func (eval Evaluator) RotateRows(op0, op1 *rlwe.Ciphertext) (err error) {
+	if err = eval.checkNTTDomain(op0); err != nil {
+		return fmt.Errorf("cannot RotateRows: %w", err)
+	}
 	return eval.Automorphism(op0, eval.parameters.GaloisElementForRowRotation(), op1)
 }
 
+// ExtractSlot isolates the i-th slot of op0 into slot 0 of op1, masking out every other slot.
+// The slot is first masked with a fresh plaintext mask and then brought to slot 0 with a column
+// rotation, followed by a row rotation when the slot lies in the second row of the packing matrix.
+// The procedure will return an error if the corresponding Galois keys have not been generated and
+// attributed to the evaluator.
+func (eval Evaluator) ExtractSlot(op0 *rlwe.Ciphertext, i int, op1 *rlwe.Ciphertext) (err error) {
+
+	params := eval.GetParameters()
+
+	slots := params.MaxSlots()
+
+	if i < 0 || i >= slots {
+		return fmt.Errorf("cannot ExtractSlot: slot index %d not in [0, %d)", i, slots)
+	}
+
+	cols := params.MaxDimensions().Cols
+
+	// Masks out every slot but the i-th
+	mask := make([]uint64, slots)
+	mask[i] = 1
+
+	if err = eval.Mul(op0, mask, op1); err != nil {
+		return fmt.Errorf("cannot ExtractSlot: %w", err)
+	}
+
+	// Brings the masked slot to the first column
+	if k := i % cols; k != 0 {
+		if err = eval.RotateColumns(op1, k, op1); err != nil {
+			return fmt.Errorf("cannot ExtractSlot: %w", err)
+		}
+	}
+
+	// Brings the masked slot to the first row
+	if i >= cols {
+		if err = eval.RotateRows(op1, op1); err != nil {
+			return fmt.Errorf("cannot ExtractSlot: %w", err)
+		}
+	}
+
+	return
+}
+
+// Repack merges multiple sparsely-occupied ciphertexts into a single densely-packed one.
+// The first slotCounts[i] slots of each cts[i] are packed contiguously into opOut, in order:
+// the slots of cts[0] start at slot 0, the slots of cts[1] at slot slotCounts[0], and so on.
+// The remaining slots of each input are masked out. This method is the dual of
+// [Evaluator.ExtractSlot].
+//
+// Each input ciphertext contributes at most two masked rotations: a single column rotation
+// by its destination offset and, for the slots whose destination lies in the other row of
+// the packing matrix, an additional row rotation. The required Galois elements are returned
+// by [Parameters.GaloisElementsForRepack] with the same slot counts.
+//
+// The procedure will return an error if len(cts) != len(slotCounts), if a slot count is
+// negative, if the slot counts sum to more than params.MaxSlots() or if a required Galois
+// key is missing from the EvaluationKeySet.
+func (eval Evaluator) Repack(cts []*rlwe.Ciphertext, slotCounts []int, opOut *rlwe.Ciphertext) (err error) {
+
+	params := eval.GetParameters()
+
+	slots := params.MaxSlots()
+	cols := params.MaxDimensions().Cols
+
+	if len(cts) != len(slotCounts) {
+		return fmt.Errorf("cannot Repack: len(cts)=%d != len(slotCounts)=%d", len(cts), len(slotCounts))
+	}
+
+	var total int
+	for _, n := range slotCounts {
+		if n < 0 {
+			return fmt.Errorf("cannot Repack: invalid slot count %d: must be non-negative", n)
+		}
+		total += n
+	}
+
+	if total > slots {
+		return fmt.Errorf("cannot Repack: slot counts sum to %d > %d slots", total, slots)
+	}
+
+	if missing := eval.MissingGaloisKeys(params.GaloisElementsForRepack(slotCounts)); len(missing) > 0 {
+		return fmt.Errorf("cannot Repack: %w: GaloisKeys for galEls %v are not available in the EvaluationKeySet", rlwe.ErrMissingGaloisKey, missing)
+	}
+
+	level := opOut.Level()
+	for _, ct := range cts {
+		level = min(level, ct.Level())
+	}
+
+	tmp := NewCiphertext(eval.parameters, 1, level)
+
+	mask := make([]uint64, slots)
+
+	var acc *rlwe.Ciphertext
+	var offset int
+	for i, ct := range cts {
+
+		n := slotCounts[i]
+
+		if n == 0 {
+			continue
+		}
+
+		k := offset % cols
+
+		// The slots whose destination stays in the same row only require the
+		// column rotation; those whose destination lies in the other row are
+		// masked separately and additionally rotated by one row.
+		for _, flip := range []bool{false, true} {
+
+			clear(mask)
+
+			var count int
+			for j := 0; j < n; j++ {
+				if ((j < cols) != (offset+j < cols)) == flip {
+					mask[j] = 1
+					count++
+				}
+			}
+
+			if count == 0 {
+				continue
+			}
+
+			if err = eval.Mul(ct, mask, tmp); err != nil {
+				return fmt.Errorf("cannot Repack: %w", err)
+			}
+
+			if k != 0 {
+				if err = eval.RotateColumns(tmp, -k, tmp); err != nil {
+					return fmt.Errorf("cannot Repack: %w", err)
+				}
+			}
+
+			if flip {
+				if err = eval.RotateRows(tmp, tmp); err != nil {
+					return fmt.Errorf("cannot Repack: %w", err)
+				}
+			}
+
+			if acc == nil {
+				acc = tmp.Clone()
+			} else if err = eval.Add(acc, tmp, acc); err != nil {
+				return fmt.Errorf("cannot Repack: %w", err)
+			}
+		}
+
+		offset += n
+	}
+
+	// All the slot counts are zero: the output is an encryption of zero
+	if acc == nil {
+		acc = NewCiphertext(eval.parameters, 1, level)
+	}
+
+	opOut.ResizeQ(acc.LevelQ())
+	opOut.ResizeDegree(acc.Degree())
+	opOut.Copy(acc)
+
+	return
+}
+
 // RotateHoistedLazyNew applies a series of rotations on the same ciphertext and returns each different rotation in a map indexed by the rotation.
 // Results are not rescaled by P.
 func (eval Evaluator) RotateHoistedLazyNew(level int, rotations []int, op0 *rlwe.Ciphertext, buf rlwe.HoistingBuffer) (op1 map[int]*rlwe.Ciphertext, err error) {
@@ -1414,22 +1875,37 @@ func (eval Evaluator) GetRLWEParameters() *rlwe.Parameters {
 }
 
 func (eval Evaluator) matchScalesBinary(scale0, scale1 uint64) (r0, r1, e uint64) {
+	return MatchScales(scale0, scale1, eval.parameters.RT.Modulus)
+}
 
-	rT := eval.parameters.RT
+// MatchScales returns the pair (r0, r1) such that scale0 * r0 = scale1 * r1 mod T,
+// among the candidates produced by the extended-GCD search, the one minimizing
+// |r0| + |r1| (values being centered around 0 mod T), i.e. the one minimizing the
+// noise added by the scale matching. The third return value is that minimal
+// |r0| + |r1|. It is the routine used internally to align the scales of two
+// operands before an addition (see [Evaluator.MatchScalesAndLevel]) and is
+// exported for users implementing custom addition or fusion operations.
+//
+// The scales must satisfy gcd(scale0, T) == gcd(scale1, T) == 1, i.e. be units
+// of Z_T (which holds for any valid ciphertext scale); the method panics
+// otherwise.
+func MatchScales(scale0, scale1, T uint64) (r0, r1, e uint64) {
 
-	t := rT.Modulus
+	t := T
 	tHalf := t >> 1
-	BRedConstant := rT.BRedConstant
+	BRedConstant := ring.GetBRedConstant(t)
+
+	inv := new(big.Int).ModInverse(new(big.Int).SetUint64(scale0), new(big.Int).SetUint64(t))
 
-	// This should never happen and if it were to happen,
-	// there is no way to recovernfrom it.
-	if utils.GCD(scale0, t) != 1 {
-		panic("cannot matchScalesBinary: invalid ciphertext scale: gcd(scale, t) != 1")
+	// This should never happen for a valid ciphertext scale and if it were
+	// to happen, there is no way to recover from it.
+	if inv == nil {
+		panic("cannot MatchScales: invalid scale: gcd(scale0, T) != 1")
 	}
 
 	var a = t
 	var b uint64 = 0
-	var A = ring.BRed(ring.ModExp(scale0, rT.Phi()-1, t), scale1, t, BRedConstant)
+	var A = ring.BRed(inv.Uint64(), scale1, t, BRedConstant)
 	var B uint64 = 1
 
 	r0, r1 = A, B
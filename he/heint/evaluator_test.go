@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Pro7ech/lattigo/he"
 	"github.com/Pro7ech/lattigo/he/heint"
+	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
 	"github.com/stretchr/testify/require"
 )
@@ -44,6 +46,54 @@ func testEvaluator(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/WithStrictScales", tc.params, lvl), func(t *testing.T) {
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+			v1, _, ciphertext1 := newTestVectorsLvl(lvl, tc.params.NewScale(7), tc, tc.encryptorSk)
+
+			require.True(t, ct0.Scale.Cmp(ciphertext1.Scale) != 0)
+
+			strict := tc.evaluator.WithStrictScales()
+
+			// Mismatched scales are a hard failure instead of a silent scale matching
+			require.ErrorIs(t, strict.Add(ct0, ciphertext1, ct0), rlwe.ErrScaleMismatch)
+			require.ErrorIs(t, strict.Sub(ct0, ciphertext1, ct0), rlwe.ErrScaleMismatch)
+
+			// Matching scales behave as with the regular evaluator
+			v2, _, ciphertext2 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+
+			require.NoError(t, strict.Add(ct0, ciphertext2, ct0))
+			tc.rT.Add(v0, v2, v0)
+
+			verifyTestVectors(tc, tc.decryptor, v0, ct0, t)
+
+			// The receiver is left unaffected
+			require.NoError(t, tc.evaluator.Add(ct0, ciphertext1, ct0))
+			tc.rT.Add(v0, v1, v0)
+
+			verifyTestVectors(tc, tc.decryptor, v0, ct0, t)
+		})
+	}
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/NTTDomain", tc.params, lvl), func(t *testing.T) {
+
+			_, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+			_, _, ct1 := newTestVectorsLvl(lvl, tc.params.NewScale(7), tc, tc.encryptorSk)
+
+			// Operands in the wrong NTT domain are rejected with an explicit error
+			ct0.IsNTT = !ct0.IsNTT
+
+			require.Error(t, tc.evaluator.Add(ct0, ct1, ct0))
+			require.Error(t, tc.evaluator.Add(ct1, ct0, ct1))
+			require.Error(t, tc.evaluator.Mul(ct0, ct1, ct0))
+			require.Error(t, tc.evaluator.Rescale(ct0, ct0))
+			require.Error(t, tc.evaluator.RotateColumns(ct0, 1, ct0))
+			require.Error(t, tc.evaluator.RotateRows(ct0, ct0))
+		})
+	}
+
 	for _, lvl := range tc.testLevel {
 		t.Run(GetTestName("Evaluator/Add/Ct/Pt/Inplace", tc.params, lvl), func(t *testing.T) {
 
@@ -298,6 +348,31 @@ func testEvaluator(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/AffineScalar/Ct/Inplace", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			values, _, ciphertext := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+			T := tc.params.PlaintextModulus()
+
+			mul := T >> 1
+			add := T >> 2
+
+			require.Error(t, tc.evaluator.AffineScalar(ciphertext, T, add, ciphertext))
+			require.Error(t, tc.evaluator.AffineScalar(ciphertext, mul, T, ciphertext))
+
+			require.NoError(t, tc.evaluator.AffineScalar(ciphertext, mul, add, ciphertext))
+			tc.rT.MulScalar(values, mul, values)
+			tc.rT.AddScalar(values, add, values)
+
+			verifyTestVectors(tc, tc.decryptor, values, ciphertext, t)
+		})
+	}
+
 	for _, lvl := range tc.testLevel {
 		t.Run(GetTestName("Evaluator/Mul/Ct/Vector/Inplace", tc.params, lvl), func(t *testing.T) {
 
@@ -354,6 +429,89 @@ func testEvaluator(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/Square/Ct/Inplace", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+
+			want := make([]uint64, len(v0))
+			tc.rT.MulCoeffsBarrett(v0, v0, want)
+
+			res, err := tc.evaluator.SquareNew(ct0)
+			require.NoError(t, err)
+			require.Equal(t, 1, res.Degree())
+
+			require.NoError(t, tc.evaluator.Rescale(res, res))
+
+			verifyTestVectors(tc, tc.decryptor, want, res, t)
+
+			// In-place squaring
+			require.NoError(t, tc.evaluator.Square(ct0, ct0))
+			require.NoError(t, tc.evaluator.Rescale(ct0, ct0))
+
+			verifyTestVectors(tc, tc.decryptor, want, ct0, t)
+		})
+	}
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/Mask", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+
+			from, to := len(v0)>>2, len(v0)>>1
+
+			want := make([]uint64, len(v0))
+			copy(want[from:to], v0[from:to])
+
+			res := heint.NewCiphertext(tc.params, 1, lvl)
+			require.NoError(t, tc.evaluator.Mask(ct0, from, to, res))
+
+			// No level is consumed and the scale is unchanged
+			require.Equal(t, lvl, res.Level())
+			require.Equal(t, 0, res.Scale.Cmp(ct0.Scale))
+
+			verifyTestVectors(tc, tc.decryptor, want, res, t)
+
+			// The range must satisfy 0 <= from <= to <= slots
+			require.Error(t, tc.evaluator.Mask(ct0, -1, to, res))
+			require.Error(t, tc.evaluator.Mask(ct0, to, from, res))
+			require.Error(t, tc.evaluator.Mask(ct0, from, tc.params.MaxSlots()+1, res))
+		})
+	}
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/MulRelinRescale/Ct/Ct/Inplace", tc.params, lvl), func(t *testing.T) {
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.NewScale(3), tc, tc.encryptorSk)
+			v1, _, ciphertext1 := newTestVectorsLvl(lvl, tc.params.NewScale(7), tc, tc.encryptorSk)
+
+			if lvl == 0 {
+				require.Error(t, tc.evaluator.MulRelinRescale(ct0, ciphertext1, ct0))
+				t.Skip("Skipping: Level = 0")
+			}
+
+			tc.rT.MulCoeffsBarrett(v0, v1, v0)
+
+			require.True(t, ct0.Scale.Cmp(ciphertext1.Scale) != 0)
+
+			receiver := heint.NewCiphertext(tc.params, 1, lvl)
+
+			require.NoError(t, tc.evaluator.MulRelinRescale(ct0, ciphertext1, receiver))
+
+			require.Equal(t, lvl-1, receiver.Level())
+
+			verifyTestVectors(tc, tc.decryptor, v0, receiver, t)
+		})
+	}
+
 	for _, lvl := range tc.testLevel {
 		t.Run(GetTestName("Evaluator/MulThenAdd/Ct/Ct/Inplace", tc.params, lvl), func(t *testing.T) {
 
@@ -464,6 +622,63 @@ func testEvaluator(tc *testContext, t *testing.T) {
 		})
 	}
 
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/RelinAccumulator", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			acc := he.NewRelinAccumulator(tc.evaluator)
+
+			res := heint.NewCiphertext(tc.params, 1, lvl)
+
+			// Finalizing an empty accumulator must fail
+			require.Error(t, acc.Finalize(res))
+
+			want := make([]uint64, tc.params.MaxSlots())
+
+			// Inner product between two vectors of three ciphertexts,
+			// with a single relinearization at the end
+			for range 3 {
+
+				v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+				v1, _, ciphertext1 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+				require.NoError(t, acc.MulThenAdd(ct0, ciphertext1))
+
+				tc.rT.MulCoeffsBarrettThenAdd(v0, v1, want)
+			}
+
+			require.NoError(t, acc.Finalize(res))
+			require.Equal(t, 1, res.Degree())
+
+			verifyTestVectors(tc, tc.decryptor, want, res, t)
+
+			// The accumulator is reset by Finalize
+			require.Error(t, acc.Finalize(res))
+		})
+	}
+
+	t.Run(GetTestName("Evaluator/MatchScales", tc.params, tc.params.MaxLevel()), func(t *testing.T) {
+
+		T := tc.params.PlaintextModulus()
+		brc := ring.GetBRedConstant(T)
+
+		for _, scales := range [][2]uint64{{3, 7}, {17, 1}, {1, 19}, {23, 23}} {
+
+			scale0, scale1 := scales[0], scales[1]
+
+			r0, r1, _ := heint.MatchScales(scale0, scale1, T)
+
+			// The scaled operands must end up at the same scale
+			require.Equal(t, ring.BRed(scale0, r0, T, brc), ring.BRed(scale1, r1, T, brc))
+		}
+
+		// Scales that are not units of Z_T are rejected
+		require.Panics(t, func() { heint.MatchScales(0, 3, T) })
+	})
+
 	for _, lvl := range tc.testLevel[:] {
 		t.Run(GetTestName("Evaluator/Rescale", tc.params, lvl), func(t *testing.T) {
 
@@ -511,4 +726,166 @@ func testEvaluator(tc *testContext, t *testing.T) {
 			}
 		})
 	}
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/ModSwitchDown", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+			v1, _, ciphertext1 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+			// A scale-invariant multiplication, so that the switched ciphertext carries a non-trivial scale
+			require.NoError(t, tc.evaluator.MulRelinScaleInvariant(ct0, ciphertext1, ct0))
+			tc.rT.MulCoeffsBarrett(v0, v1, v0)
+
+			verifyTestVectors(tc, tc.decryptor, v0, ct0, t)
+
+			require.NotNil(t, tc.evaluator.ModSwitchDown(ct0, ct0.Level()+1))
+
+			require.NoError(t, tc.evaluator.ModSwitchDown(ct0, ct0.Level()))
+			require.Equal(t, 0, ct0.Level())
+
+			verifyTestVectors(tc, tc.decryptor, v0, ct0, t)
+		})
+	}
+}
+
+func testEvaluatorInnerSumLog(tc *testContext, t *testing.T) {
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/InnerSumLog", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			batch, n := 1, 4
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+			galEls := tc.params.GaloisElementsForInnerSum(batch, n)
+
+			buf := tc.evaluator.NewHoistingBuffer(ct0.LevelQ(), tc.params.MaxLevelP())
+
+			// Without the Galois keys the evaluation must fail up front,
+			// with an error listing all the missing Galois elements
+			err := tc.evaluator.InnerSumLog(ct0, batch, n, buf, ct0)
+			require.ErrorIs(t, err, rlwe.ErrMissingGaloisKey)
+			for _, galEl := range galEls {
+				require.ErrorContains(t, err, fmt.Sprintf("%d", galEl))
+			}
+
+			eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...))
+
+			require.NoError(t, eval.InnerSumLog(ct0, batch, n, buf, ct0))
+
+			// Applies the same circuit on the plaintext
+			T := tc.params.PlaintextModulus()
+			cols := tc.params.MaxDimensions().Cols
+			want := make([]uint64, len(v0))
+			for r := 0; r < len(v0); r += cols {
+				for i := range cols {
+					var acc uint64
+					for j := range n {
+						acc = (acc + v0[r+(i+j*batch)%cols]) % T
+					}
+					want[r+i] = acc
+				}
+			}
+
+			verifyTestVectors(tc, tc.decryptor, want, ct0, t)
+		})
+	}
+}
+
+func testEvaluatorRepack(tc *testContext, t *testing.T) {
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/Repack", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+			v1, _, ct1 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+			v2, _, ct2 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+			cols := tc.params.MaxDimensions().Cols
+
+			// The last destination range crosses the row boundary of the packing matrix
+			slotCounts := []int{3, cols - 5, 7}
+
+			cts := []*rlwe.Ciphertext{ct0, ct1, ct2}
+
+			// Without the Galois keys the repacking must fail
+			err := tc.evaluator.Repack(cts, slotCounts, heint.NewCiphertext(tc.params, 1, lvl))
+			require.ErrorIs(t, err, rlwe.ErrMissingGaloisKey)
+
+			// Mismatched lengths and negative slot counts are rejected
+			require.Error(t, tc.evaluator.Repack(cts, []int{3, 7}, ct0))
+			require.Error(t, tc.evaluator.Repack(cts, []int{3, -1, 7}, ct0))
+			require.Error(t, tc.evaluator.Repack(cts, []int{3, 7, tc.params.MaxSlots()}, ct0))
+
+			galEls := tc.params.GaloisElementsForRepack(slotCounts)
+			eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...))
+
+			opOut := heint.NewCiphertext(tc.params, 1, lvl)
+			require.NoError(t, eval.Repack(cts, slotCounts, opOut))
+
+			want := make([]uint64, tc.params.MaxSlots())
+			offset := 0
+			for i, values := range [][]uint64{v0, v1, v2} {
+				copy(want[offset:offset+slotCounts[i]], values)
+				offset += slotCounts[i]
+			}
+
+			require.Equal(t, lvl, opOut.Level())
+
+			verifyTestVectors(tc, tc.decryptor, want, opOut, t)
+		})
+	}
+}
+
+func testEvaluatorExtractSlot(tc *testContext, t *testing.T) {
+
+	for _, lvl := range tc.testLevel {
+		t.Run(GetTestName("Evaluator/ExtractSlot", tc.params, lvl), func(t *testing.T) {
+
+			if lvl == 0 {
+				t.Skip("Skipping: Level = 0")
+			}
+
+			v0, _, ct0 := newTestVectorsLvl(lvl, tc.params.DefaultScale(), tc, tc.encryptorSk)
+
+			cols := tc.params.MaxDimensions().Cols
+
+			// A slot in the second row, to exercise both the column and the row rotation
+			i := cols + 3
+
+			// Without the Galois keys the extraction must fail
+			require.Error(t, tc.evaluator.ExtractSlot(ct0, i, heint.NewCiphertext(tc.params, 1, lvl)))
+
+			// Index out of range
+			require.Error(t, tc.evaluator.ExtractSlot(ct0, tc.params.MaxSlots(), ct0))
+
+			galEls := []uint64{tc.params.GaloisElement(i % cols), tc.params.GaloisElementForRowRotation()}
+			eval := tc.evaluator.WithKey(rlwe.NewMemEvaluationKeySet(nil, tc.kgen.GenGaloisKeysNew(galEls, tc.sk)...))
+
+			opOut := heint.NewCiphertext(tc.params, 1, lvl)
+			require.NoError(t, eval.ExtractSlot(ct0, i, opOut))
+
+			pt := tc.decryptor.DecryptNew(opOut)
+			have := make([]uint64, tc.params.MaxSlots())
+			require.NoError(t, tc.encoder.Decode(pt, have))
+
+			require.Equal(t, v0[i], have[0])
+			for j := 1; j < len(have); j++ {
+				require.Equal(t, uint64(0), have[j])
+			}
+		})
+	}
 }
@@ -78,8 +78,13 @@ func TestHEInt(t *testing.T) {
 				testParameters,
 				testEncoder,
 				testEvaluator,
+				testEvaluatorInnerSumLog,
+				testEvaluatorExtractSlot,
+				testEvaluatorRepack,
+				testComparison,
 				testLinearTransformation,
 				testPolynomialEvaluator,
+				testEvaluateLUT,
 			} {
 				testSet(tc, t)
 				runtime.GC()
@@ -0,0 +1,118 @@
+package heint
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+	"github.com/Pro7ech/lattigo/utils/bignum"
+)
+
+// InterpolateLUT returns the coefficients in the monomial basis of the unique polynomial
+// of degree at most T-1 over Z_T interpolating the provided table, i.e. of the polynomial
+// P satisfying P(x) = table[x] mod T for all 0 <= x < T. The modulus T must be prime, so
+// that Z_T is a field and the Lagrange interpolation is defined. The interpolation is
+// evaluated with O(T^2) modular operations, thus the method is only practical for small T.
+// Trailing zero coefficients are trimmed, so that tables that happen to be low-degree
+// polynomials are evaluated with the matching depth.
+func InterpolateLUT(table []uint64, T uint64) (coeffs []uint64, err error) {
+
+	if uint64(len(table)) != T {
+		return nil, fmt.Errorf("invalid table: should have %d entries but has %d", T, len(table))
+	}
+
+	n := len(table)
+
+	brc := ring.GetBRedConstant(T)
+
+	// M(X) = prod_{i} (X - i)
+	m := make([]uint64, n+1)
+	m[0] = 1
+	for i := range n {
+		negi := (T - uint64(i)) % T
+		for k := i + 1; k >= 1; k-- {
+			m[k] = ring.CRed(m[k-1]+ring.BRed(negi, m[k], T, brc), T)
+		}
+		m[0] = ring.BRed(negi, m[0], T, brc)
+	}
+
+	coeffs = make([]uint64, n)
+	q := make([]uint64, n)
+
+	for i := range n {
+
+		yi := table[i] % T
+
+		if yi == 0 {
+			continue
+		}
+
+		// q(X) = M(X)/(X - i) by synthetic division
+		xi := uint64(i)
+		q[n-1] = m[n]
+		for k := n - 2; k >= 0; k-- {
+			q[k] = ring.CRed(m[k+1]+ring.BRed(xi, q[k+1], T, brc), T)
+		}
+
+		// M'(i) = q(i) by Horner
+		var d uint64
+		for k := n - 1; k >= 0; k-- {
+			d = ring.CRed(ring.BRed(d, xi, T, brc)+q[k], T)
+		}
+
+		// coeffs += table[i]/M'(i) * q
+		w := ring.BRed(yi, ring.ModExp(d, T-2, T), T, brc)
+		for k := range n {
+			coeffs[k] = ring.CRed(coeffs[k]+ring.BRed(w, q[k], T, brc), T)
+		}
+	}
+
+	deg := n - 1
+	for deg > 0 && coeffs[deg] == 0 {
+		deg--
+	}
+
+	return coeffs[:deg+1], nil
+}
+
+// EvaluateLUT evaluates an arbitrary lookup table on the encrypted integers of ct, writing
+// in each slot of opOut the value table[x], where x is the value of the corresponding slot
+// of ct. The table must have exactly T entries, where T is the plaintext modulus, which
+// must be prime (i.e. the base plaintext modulus power must be 1) for the interpolation
+// to be defined.
+//
+// The table is expressed as its interpolating polynomial of degree at most T-1 over Z_T
+// (see [InterpolateLUT]), which is evaluated homomorphically: with the default (BGV-style)
+// tensoring the evaluation consumes ceil(log2(T)) levels, while with the invariant
+// (BFV-style) tensoring it consumes none. With the default tensoring the output is
+// rescaled back to the scale of ct.
+func (eval PolynomialEvaluator) EvaluateLUT(ct *rlwe.Ciphertext, table []uint64, opOut *rlwe.Ciphertext) (err error) {
+
+	params := eval.Parameters
+
+	if params.BasePlaintextModulusPower() != 1 {
+		return fmt.Errorf("cannot EvaluateLUT: the plaintext modulus must be prime but is a prime power")
+	}
+
+	coeffs, err := InterpolateLUT(table, params.PlaintextModulus())
+	if err != nil {
+		return fmt.Errorf("cannot EvaluateLUT: %w", err)
+	}
+
+	res, err := eval.Evaluate(ct, bignum.NewPolynomial(bignum.Monomial, coeffs, nil), ct.Scale)
+	if err != nil {
+		return fmt.Errorf("cannot EvaluateLUT: %w", err)
+	}
+
+	if !eval.InvariantTensoring && res.Level() > 0 {
+		if err = eval.Rescale(res, res); err != nil {
+			return fmt.Errorf("cannot EvaluateLUT: %w", err)
+		}
+	}
+
+	opOut.ResizeQ(res.LevelQ())
+	opOut.ResizeDegree(res.Degree())
+	opOut.Copy(res)
+
+	return
+}
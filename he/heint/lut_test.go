@@ -0,0 +1,77 @@
+package heint_test
+
+import (
+	"testing"
+
+	"github.com/Pro7ech/lattigo/he/heint"
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/utils/sampling"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvaluateLUT(tc *testContext, t *testing.T) {
+
+	t.Run(GetTestName("EvaluateLUT", tc.params, tc.params.MaxLevel()), func(t *testing.T) {
+
+		T := tc.params.PlaintextModulus()
+
+		if tc.params.BasePlaintextModulusPower() != 1 {
+			polyEval := heint.NewPolynomialEvaluator(tc.params, tc.evaluator, true)
+			_, _, ct := newTestVectorsLvl(tc.params.MaxLevel(), tc.params.DefaultScale(), tc, tc.encryptorSk)
+			require.Error(t, polyEval.EvaluateLUT(ct, []uint64{0}, ct))
+			return
+		}
+
+		if T > 1<<10 {
+			t.Skip("PlaintextModulus too large for the O(T^2) interpolation")
+		}
+
+		source := sampling.NewSource(sampling.NewSeed())
+
+		table := make([]uint64, T)
+		for i := range table {
+			table[i] = source.Uint64() % T
+		}
+
+		// checks the interpolation in the clear
+		coeffs, err := heint.InterpolateLUT(table, T)
+		require.NoError(t, err)
+		for i := range table {
+			require.Equal(t, table[i], ring.EvalPolyModP(uint64(i), coeffs, T))
+		}
+
+		_, err = heint.InterpolateLUT(table[:T-1], T)
+		require.Error(t, err)
+
+		// the depth of the test parameters does not allow a degree T-1 evaluation,
+		// thus the encrypted evaluation is checked on a table interpolating to a
+		// low-degree polynomial, thanks to the trailing-zero trimming
+		for i := range table {
+			table[i] = ring.EvalPolyModP(uint64(i), []uint64{7, 3, 1}, T)
+		}
+
+		values := make([]uint64, tc.params.MaxSlots())
+		for i := range values {
+			values[i] = uint64(i) % T
+		}
+
+		pt := heint.NewPlaintext(tc.params, tc.params.MaxLevel())
+		require.NoError(t, tc.encoder.Encode(values, pt))
+
+		ct := heint.NewCiphertext(tc.params, 1, pt.Level())
+		require.NoError(t, tc.encryptorSk.Encrypt(pt, ct))
+
+		want := make([]uint64, len(values))
+		for i := range values {
+			want[i] = table[values[i]]
+		}
+
+		polyEval := heint.NewPolynomialEvaluator(tc.params, tc.evaluator, true)
+
+		res := heint.NewCiphertext(tc.params, 1, ct.Level())
+		require.NoError(t, polyEval.EvaluateLUT(ct, table, res))
+		require.Equal(t, ct.Level(), res.Level())
+
+		verifyTestVectors(tc, tc.decryptor, want, res, t)
+	})
+}
@@ -242,6 +242,42 @@ func (p Parameters) GaloisElementsForReplicate(batch, n int) (galEls []uint64) {
 	return
 }
 
+// GaloisElementsForRepack returns the list of Galois elements necessary to apply the
+// method [Evaluator.Repack] with the given slot counts: one column rotation per distinct
+// non-zero destination offset modulo the number of columns, plus the row rotation if the
+// destination range of a ciphertext crosses the row boundary of the packing matrix.
+func (p Parameters) GaloisElementsForRepack(slotCounts []int) (galEls []uint64) {
+
+	cols := p.MaxDimensions().Cols
+
+	seen := map[int]bool{}
+
+	var rows bool
+	var offset int
+	for _, n := range slotCounts {
+
+		if k := offset % cols; k != 0 && n > 0 && !seen[k] {
+			seen[k] = true
+			galEls = append(galEls, p.GaloisElementForColRotation(-k))
+		}
+
+		for j := 0; j < n; j++ {
+			if (j < cols) != (offset+j < cols) {
+				rows = true
+				break
+			}
+		}
+
+		offset += n
+	}
+
+	if rows {
+		galEls = append(galEls, p.GaloisElementForRowRotation())
+	}
+
+	return
+}
+
 // GaloisElementsForTrace returns the list of Galois elements requored for the for the `Trace` operation.
 // Trace maps X -> sum((-1)^i * X^{i*n+1}) for 2^{LogN} <= i < N.
 func (p Parameters) GaloisElementsForTrace(logN int) []uint64 {
@@ -52,6 +52,40 @@ func testPolynomialEvaluator(tc *testContext, t *testing.T) {
 			})
 		})
 
+		t.Run("MaxDepth", func(t *testing.T) {
+
+			if tc.params.MaxLevel() < 4 {
+				t.Skip("MaxLevel() to low")
+			}
+
+			values, _, ciphertext := newTestVectorsLvl(tc.params.MaxLevel(), tc.params.NewScale(1), tc, tc.encryptorSk)
+
+			// Degree-3 polynomial: the Paterson-Stockmeyer evaluation consumes
+			// ceil(log2(3+1)) = 2 levels, the Horner fallback 3 levels.
+			coeffs := []uint64{1, 2, 3, 4}
+
+			T := tc.params.PlaintextModulus()
+			for i := range values {
+				values[i] = ring.EvalPolyModP(values[i], coeffs, T)
+			}
+
+			poly := bignum.NewPolynomial(bignum.Monomial, coeffs, nil)
+
+			polyEval := heint.NewPolynomialEvaluator(tc.params, tc.evaluator, false)
+
+			// An insufficient depth budget must be rejected up-front
+			_, err := polyEval.Evaluate(ciphertext, poly, tc.params.DefaultScale(), he.WithMaxDepth(1))
+			require.Error(t, err)
+
+			// A budget matching the Paterson-Stockmeyer depth must succeed and
+			// suppress the deeper Horner fallback
+			res, err := polyEval.Evaluate(ciphertext, poly, tc.params.DefaultScale(), he.WithMaxDepth(2))
+			require.NoError(t, err)
+			require.NoError(t, polyEval.Rescale(res, res))
+			require.Equal(t, ciphertext.Level()-2, res.Level())
+			verifyTestVectors(tc, tc.decryptor, values, res, t)
+		})
+
 		t.Run("Vector", func(t *testing.T) {
 
 			if tc.params.MaxLevel() < 4 {
@@ -52,6 +52,54 @@ func (m Diagonals[T]) Mul(d Diagonals[T], buff []T, add func(a, b, c []T), rot f
 	}
 }
 
+// Transpose returns the diagonals of the transposed matrix, i.e. the adjoint linear map.
+// The receiver is interpreted as the diagonal representation of a square matrix M of
+// dimension slots, with m[i][k] = M[k][(k+i)%slots]. The receiver is not modified.
+func (m Diagonals[T]) Transpose(slots int, clone func(a []T) (b []T)) Diagonals[T] {
+	out := Diagonals[T]{}
+	for i, mi := range m {
+		k := ((-i)%slots + slots) % slots
+		out[k] = clone(utils.RotateSlice(mi, -i))
+	}
+	return out
+}
+
+// Compose returns the diagonals of the matrix product M x D, where M is the matrix whose
+// diagonals are the receiver and D the matrix whose diagonals are other, i.e. the returned
+// linear map applies other first and the receiver second. The composition is carried out
+// in the clear on the diagonal representation, following
+//
+//	diag_{i+j}(M x D) += diag_i(M) * rot_i(diag_j(D)),
+//
+// enabling the composite of two linear transformations to be encoded with a single
+// [EncodeLinearTransformation] and evaluated in a single BSGS pass, instead of consuming
+// two rotation sets and two levels.
+// - add: evaluates c[i] = a[i] + b[i]
+// - mul: evaluates c[i] = a[i] * b[i]
+// The receiver and other are not modified.
+func (m Diagonals[T]) Compose(other Diagonals[T], slots int, add func(a, b, c []T), mul func(a, b, c []T), clone func(a []T) (b []T)) Diagonals[T] {
+
+	out := Diagonals[T]{}
+
+	for i, mi := range m {
+		for j, dj := range other {
+
+			buff := clone(utils.RotateSlice(dj, i))
+			mul(mi, buff, buff)
+
+			k := ((i+j)%slots + slots) % slots
+
+			if outk, ok := out[k]; ok {
+				add(outk, buff, outk)
+			} else {
+				out[k] = buff
+			}
+		}
+	}
+
+	return out
+}
+
 // Indexes returns the list of the non-zero diagonals of the square matrix.
 // A non zero diagonals is a diagonal with a least one non-zero element.
 func (m Diagonals[T]) Indexes() (indexes []int) {
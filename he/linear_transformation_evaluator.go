@@ -88,6 +88,29 @@ func (eval LinearTransformationEvaluator) Evaluate(ctIn *rlwe.Ciphertext, linear
 	return EvaluateLinearTransformationsMany(eval.EvaluatorForLinearTransformation, eval.EvaluatorForDiagonalMatrix, ctIn, []*LinearTransformation{linearTransformation}, buf, []*rlwe.Ciphertext{opOut})
 }
 
+// EvaluateAndRescale takes as input a ciphertext ctIn, a linear transformation M and evaluates opOut: M(ctIn),
+// rescaling the result by the scale of the linear transformation.
+// Rescaling operations are applied until the scaling factor introduced by the evaluation has been
+// consumed, i.e. until the scale of opOut is at most the scale of ctIn. The Scale field of the
+// linear transformation should thus correspond to a product of moduli of the chain, which is how
+// [NewLinearTransformation] instantiates it.
+func (eval LinearTransformationEvaluator) EvaluateAndRescale(ctIn *rlwe.Ciphertext, linearTransformation *LinearTransformation, buf rlwe.HoistingBuffer, opOut *rlwe.Ciphertext) (err error) {
+
+	scale := ctIn.Scale
+
+	if err = eval.Evaluate(ctIn, linearTransformation, buf, opOut); err != nil {
+		return err
+	}
+
+	for opOut.Scale.Cmp(scale) == 1 {
+		if err = eval.Rescale(opOut, opOut); err != nil {
+			return fmt.Errorf("eval.Rescale: %w", err)
+		}
+	}
+
+	return
+}
+
 // EvaluateManyNew takes as input a ciphertext ctIn and a list of linear transformations [M0, M1, M2, ...] and returns opOut:[M0(ctIn), M1(ctIn), M2(ctInt), ...].
 func (eval LinearTransformationEvaluator) EvaluateManyNew(ctIn *rlwe.Ciphertext, linearTransformations []*LinearTransformation, buf rlwe.HoistingBuffer) (opOut []*rlwe.Ciphertext, err error) {
 	params := eval.GetRLWEParameters()
@@ -33,6 +33,34 @@ func NewPolynomial(poly *bignum.Polynomial) *Polynomial {
 	}
 }
 
+// NewPolynomialFromSamples returns an instantiated Polynomial that interpolates
+// the sample points (xs[i], ys[i]), expressed in the requested basis.
+// The nodes xs can follow any user-defined distribution (e.g. clustered close to
+// a boundary) but must be pairwise distinct.
+// If the basis is bignum.Chebyshev, the interval of the returned polynomial is
+// [min(xs), max(xs)].
+func NewPolynomialFromSamples(xs, ys []*big.Float, basis bignum.Basis) (pol *Polynomial, err error) {
+
+	if len(xs) != len(ys) {
+		return nil, fmt.Errorf("invalid inputs: len(xs)=%d != len(ys)=%d", len(xs), len(ys))
+	}
+
+	X := make([]big.Float, len(xs))
+	Y := make([]big.Float, len(ys))
+
+	for i := range xs {
+		X[i].Set(xs[i])
+		Y[i].Set(ys[i])
+	}
+
+	var p *bignum.Polynomial
+	if p, err = bignum.PolynomialFromSamples(X, Y, basis); err != nil {
+		return nil, err
+	}
+
+	return NewPolynomial(p), nil
+}
+
 // Factorize factorizes p as X^{n} * pq + pr.
 func (p *Polynomial) Factorize(n int) (pq, pr *Polynomial) {
 
@@ -16,6 +16,43 @@ type EvaluatorForPolynomial interface {
 	//EvaluatePatersonStockmeyerPolynomialEncoded(poly PatersonStockmeyerPolynomialEncoded, pb PowerBasis) (res *rlwe.Ciphertext, err error)
 }
 
+// HornerDegreeThreshold is the polynomial degree below which EvaluatePolynomial
+// evaluates the polynomial with a plain Horner scheme instead of the Paterson-Stockmeyer
+// algorithm, if the underlying EvaluatorForPolynomial supports it.
+// The Paterson-Stockmeyer algorithm is asymptotically optimal but its constant overhead
+// (power-basis population and giant-step recombination) is not amortized for small degrees.
+// A Horner evaluation of a degree-d polynomial consumes d levels instead of ceil(log2(d+1)),
+// but requires no power basis and, for d = 1, no relinearization key.
+// This value is exported so that it can be tuned through benchmarks;
+// setting it to 0 disables the fallback.
+var HornerDegreeThreshold = 8
+
+// EvaluatorForHornerPolynomial defines an optional interface that an EvaluatorForPolynomial
+// can additionally implement to enable the plain Horner evaluation fallback for polynomials
+// of degree smaller than HornerDegreeThreshold.
+type EvaluatorForHornerPolynomial interface {
+	EvaluateHornerPolynomialVector(poly *PolynomialVector, x *rlwe.Ciphertext, targetScale rlwe.Scale) (res *rlwe.Ciphertext, err error)
+}
+
+// EvaluateOption is a functional option modifying the behavior of EvaluatePolynomial.
+type EvaluateOption func(*evaluateOptions)
+
+// evaluateOptions holds the optional parameters of EvaluatePolynomial.
+type evaluateOptions struct {
+	maxDepth int
+}
+
+// WithMaxDepth caps the number of levels that the polynomial evaluation is allowed to consume.
+// If the evaluation cannot be carried out within maxDepth levels, EvaluatePolynomial returns
+// an error before consuming any level, rather than failing later at a lower level.
+// A Horner evaluation (see HornerDegreeThreshold), which consumes more levels than the
+// Paterson-Stockmeyer algorithm, is only selected if it also fits within maxDepth.
+func WithMaxDepth(maxDepth int) EvaluateOption {
+	return func(opt *evaluateOptions) {
+		opt.maxDepth = maxDepth
+	}
+}
+
 // CoefficientGetter defines an interface to get the coefficients of a Polynomial.
 type CoefficientGetter[T any] interface {
 
@@ -32,7 +69,12 @@ type CoefficientGetter[T any] interface {
 }
 
 // EvaluatePolynomial is a generic and scheme agnostic method to evaluate polynomials on rlwe.Ciphertexts.
-func EvaluatePolynomial(eval EvaluatorForPolynomial, input interface{}, p interface{}, targetScale rlwe.Scale, SimEval SimEvaluator) (opOut *rlwe.Ciphertext, err error) {
+func EvaluatePolynomial(eval EvaluatorForPolynomial, input interface{}, p interface{}, targetScale rlwe.Scale, SimEval SimEvaluator, opts ...EvaluateOption) (opOut *rlwe.Ciphertext, err error) {
+
+	opt := evaluateOptions{maxDepth: -1}
+	for _, o := range opts {
+		o(&opt)
+	}
 
 	var polyVec *PolynomialVector
 	switch p := p.(type) {
@@ -63,6 +105,19 @@ func EvaluatePolynomial(eval EvaluatorForPolynomial, input interface{}, p interf
 		return nil, fmt.Errorf("%d levels < %d log(d) -> cannot evaluate poly", level, depth)
 	}
 
+	if depth := eval.LevelsConsumedPerRescaling() * polyVec.Depth(); opt.maxDepth >= 0 && depth > opt.maxDepth {
+		return nil, fmt.Errorf("cannot evaluate poly: evaluation consumes %d levels > maximum depth of %d levels", depth, opt.maxDepth)
+	}
+
+	// For degrees below HornerDegreeThreshold the constant overhead of the Paterson-Stockmeyer
+	// decomposition outweighs its savings, thus the evaluation falls back to a plain Horner
+	// scheme if the evaluator supports it and if the input carries enough levels.
+	if d := polyVec.Degree(); d >= 1 && d < HornerDegreeThreshold && (opt.maxDepth < 0 || d*eval.LevelsConsumedPerRescaling() <= opt.maxDepth) {
+		if hEval, ok := eval.(EvaluatorForHornerPolynomial); ok && powerbasis.Value[1].Level() >= d*eval.LevelsConsumedPerRescaling() {
+			return hEval.EvaluateHornerPolynomialVector(polyVec, powerbasis.Value[1], targetScale)
+		}
+	}
+
 	if err = polyVec.PopulatePowerBasis(eval, powerbasis); err != nil {
 		return nil, fmt.Errorf("polyVec.PopulatePowerBasis: %w", err)
 	}
@@ -237,7 +292,7 @@ func EvaluateMonomial(a, b, xpow *rlwe.Ciphertext, eval Evaluator) (err error) {
 	}
 
 	if !a.Scale.InDelta(b.Scale, float64(rlwe.ScalePrecision-12)) {
-		return fmt.Errorf("scale discrepency: (rescale(b) * X^{n}).Scale = %v != a.Scale = %v", &a.Scale.Value, &b.Scale.Value)
+		return fmt.Errorf("%w: (rescale(b) * X^{n}).Scale = %v != a.Scale = %v", rlwe.ErrScaleMismatch, &a.Scale.Value, &b.Scale.Value)
 	}
 
 	if err = eval.Add(b, a, b); err != nil {
@@ -247,6 +302,81 @@ func EvaluateMonomial(a, b, xpow *rlwe.Ciphertext, eval Evaluator) (err error) {
 	return
 }
 
+// EvaluateHornerPolynomialVector evaluates the PolynomialVector on the input ciphertext x with
+// a plain Horner scheme, i.e. res = (...((c_{d}*x + c_{d-1})*x + c_{d-2})*x + ...)*x + c_{0}.
+// The evaluation consumes pol.Degree() * eval.LevelsConsumedPerRescaling() levels and, for
+// polynomials of degree 1, requires no relinearization key.
+// The output follows the same convention as the Paterson-Stockmeyer evaluation: it carries
+// one pending rescaling, after which its scale is targetScale.
+// The evaluator must follow the rescaling-based scale management (i.e. not the scale
+// invariant tensoring), as the intermediate scales are planned around successive rescalings.
+func EvaluateHornerPolynomialVector[T any](eval Evaluator, pol *PolynomialVector, cg CoefficientGetter[T], x *rlwe.Ciphertext, targetScale rlwe.Scale) (res *rlwe.Ciphertext, err error) {
+
+	d := pol.Degree()
+
+	if d < 1 {
+		return nil, fmt.Errorf("cannot EvaluateHornerPolynomialVector: polynomial degree must be at least 1, but is %d", d)
+	}
+
+	params := eval.GetRLWEParameters()
+	level := x.Level()
+	nbRescales := eval.LevelsConsumedPerRescaling()
+
+	if level < d*nbRescales {
+		return nil, fmt.Errorf("cannot EvaluateHornerPolynomialVector: %w: input level (%d) is smaller than degree * LevelsConsumedPerRescaling (%d)", rlwe.ErrInsufficientLevel, level, d*nbRescales)
+	}
+
+	var coeff func(k int) rlwe.Operand
+	if pol.Mapping != nil {
+		coeff = func(k int) rlwe.Operand { return cg.GetVectorCoefficient(pol, k) }
+	} else {
+		coeff = func(k int) rlwe.Operand { return cg.GetSingleCoefficient(pol.Value[0], k) }
+	}
+
+	// Back-propagates the target scale through the d rescalings (d-1 internal
+	// and one pending) and d-1 multiplications by x to derive the scale that
+	// the leading coefficient must produce.
+	rQ := params.RingQ().AtLevel(level)
+	scale := targetScale
+	for i := 0; i < d*nbRescales; i++ {
+		scale = scale.Mul(rlwe.NewScale(rQ[level-i].Modulus))
+	}
+	for i := 0; i < d-1; i++ {
+		scale = scale.Div(x.Scale)
+	}
+
+	// res = c_{d} * x + c_{d-1}
+	res = rlwe.NewCiphertext(params, 1, level, -1)
+	*res.MetaData = *x.MetaData
+	res.Scale = scale
+
+	if err = eval.MulThenAdd(x, coeff(d), res); err != nil {
+		return nil, fmt.Errorf("eval.MulThenAdd: %w", err)
+	}
+
+	if err = eval.Add(res, coeff(d-1), res); err != nil {
+		return nil, fmt.Errorf("eval.Add: %w", err)
+	}
+
+	// res = res * x + c_{k}
+	for k := d - 2; k >= 0; k-- {
+
+		if err = eval.Rescale(res, res); err != nil {
+			return nil, fmt.Errorf("eval.Rescale: %w", err)
+		}
+
+		if err = eval.MulRelin(res, x, res); err != nil {
+			return nil, fmt.Errorf("eval.MulRelin: %w", err)
+		}
+
+		if err = eval.Add(res, coeff(k), res); err != nil {
+			return nil, fmt.Errorf("eval.Add: %w", err)
+		}
+	}
+
+	return
+}
+
 // EvaluatePolynomialVectorFromPowerBasis a method that complies to the interface he.PolynomialVectorEvaluator. This method evaluates P(ct) = sum c_i * ct^{i}.
 func EvaluatePolynomialVectorFromPowerBasis[T any](eval Evaluator, targetLevel int, pol *PolynomialVector, cg CoefficientGetter[T], pb *PowerBasis, targetScale rlwe.Scale) (res *rlwe.Ciphertext, err error) {
 
@@ -0,0 +1,62 @@
+package he
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// RelinAccumulator accumulates products of ciphertexts in the degree-2 domain and
+// relinearizes once at the end, instead of after each multiplication. For an inner
+// product between two vectors of ciphertexts, it evaluates
+// Relinearize(sum ct[i] * ct[j]) instead of sum Relinearize(ct[i] * ct[j]),
+// saving all but one of the relinearizations.
+type RelinAccumulator struct {
+	Evaluator
+	value *rlwe.Ciphertext
+}
+
+// NewRelinAccumulator returns a new [RelinAccumulator] evaluating the products and
+// the final relinearization with the provided [Evaluator], which must have been
+// instantiated with a [rlwe.RelinearizationKey].
+func NewRelinAccumulator(eval Evaluator) *RelinAccumulator {
+	return &RelinAccumulator{Evaluator: eval}
+}
+
+// MulThenAdd multiplies op0 with op1 without relinearization and adds the product to
+// the accumulator. The usual constraints of the underlying [Evaluator.MulThenAdd]
+// apply: all the products must be evaluated with operands of matching scales, so
+// that the sums in the degree-2 domain are consistent.
+func (acc *RelinAccumulator) MulThenAdd(op0 *rlwe.Ciphertext, op1 rlwe.Operand) (err error) {
+	if acc.value == nil {
+		if acc.value, err = acc.MulNew(op0, op1); err != nil {
+			return fmt.Errorf("cannot MulThenAdd: %w", err)
+		}
+		return
+	}
+	return acc.Evaluator.MulThenAdd(op0, op1, acc.value)
+}
+
+// Finalize relinearizes the accumulated value and returns the result in opOut.
+// The accumulator is reset and can be reused for a new accumulation.
+// The method returns an error if no product has been accumulated.
+func (acc *RelinAccumulator) Finalize(opOut *rlwe.Ciphertext) (err error) {
+
+	if acc.value == nil {
+		return fmt.Errorf("cannot Finalize: no product has been accumulated")
+	}
+
+	if acc.value.Degree() > 1 {
+		if err = acc.Relinearize(acc.value, opOut); err != nil {
+			return fmt.Errorf("cannot Finalize: %w", err)
+		}
+	} else {
+		opOut.ResizeQ(acc.value.LevelQ())
+		opOut.ResizeDegree(acc.value.Degree())
+		opOut.Copy(acc.value)
+	}
+
+	acc.value = nil
+
+	return
+}
@@ -2,6 +2,7 @@ package mhe
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 
@@ -102,6 +103,35 @@ func (p CircularCiphertextProtocol) Aggregate(share1, share2, share3 *CircularCi
 	return
 }
 
+// AggregateCtx is the context-aware variant of [CircularCiphertextProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p CircularCiphertextProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *CircularCiphertextShare) (err error) {
+
+	if share1.Seed != share2.Seed {
+		return fmt.Errorf("share1.Seed != share2.Seed")
+	}
+
+	share3.Seed = share1.Seed
+
+	rQ := p.GetRLWEParameters().RingQ().AtLevel(share1.RLWE1.LevelQ())
+
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	// RLWE_{u_{0}}(m_{0}) + RLWE_{u_{1}}(m_{1})
+	rQ.Add(share1.RLWE1.Q[0], share2.RLWE1.Q[0], share3.RLWE1.Q[0])
+
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	// RLWE_{s_{0}}(0) + RLWE_{s_{1}}(0)
+	rQ.Add(share1.RLWE2.Q[0], share2.RLWE2.Q[0], share3.RLWE2.Q[0])
+
+	return
+}
+
 // Finalize takes the public aggregated transcripts (share and ctu) and populates ctMS with RLWE_{s}(ms).
 func (p CircularCiphertextProtocol) Finalize(share *CircularCiphertextShare, ctU *rlwe.GadgetCiphertext, ctMS *rlwe.Ciphertext) (err error) {
 	// RLWE_{s}(0) x GRLWE_{s}(-u) + (0, RLWE_{u}(m)[0])
@@ -2,6 +2,7 @@ package mhe
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"slices"
@@ -138,6 +139,21 @@ func (p CircularGadgetCiphertextProtocol) Aggregate(share1, share2, share3 *Circ
 	return share3.GRLWE2.Vector[0].Aggregate(p.params.RingQ(), p.params.RingP(), &share2.GRLWE2.Vector[0], &share1.GRLWE2.Vector[0])
 }
 
+// AggregateCtx is the context-aware variant of [CircularGadgetCiphertextProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p CircularGadgetCiphertextProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *CircularGadgetCiphertextShare) (err error) {
+
+	if share1.Seed != share2.Seed {
+		return fmt.Errorf("shares seed do not match")
+	}
+
+	if err = aggregateMatrixCtx(ctx, p.params.RingQ(), p.params.RingP(), &share2.GRLWE1.Vector[0], &share1.GRLWE1.Vector[0], &share3.GRLWE1.Vector[0]); err != nil {
+		return
+	}
+
+	return aggregateMatrixCtx(ctx, p.params.RingQ(), p.params.RingP(), &share2.GRLWE2.Vector[0], &share1.GRLWE2.Vector[0], &share3.GRLWE2.Vector[0])
+}
+
 // FinalizeNew takes the public aggregated transcripts (share and ctu) returns ctMS with GRLWE_{s}(ms).
 func (p CircularGadgetCiphertextProtocol) FinalizeNew(share *CircularGadgetCiphertextShare, ctu *rlwe.GadgetCiphertext) (ctMS *rlwe.GadgetCiphertext, err error) {
 	params := p.params
@@ -1,6 +1,7 @@
 package mhe
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -40,6 +41,12 @@ func (p EvaluationKeyProtocol) Aggregate(share1, share2, share3 *EvaluationKeySh
 	return share3.Aggregate(p.GetRLWEParameters(), share1, share2)
 }
 
+// AggregateCtx is the context-aware variant of [EvaluationKeyProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p EvaluationKeyProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *EvaluationKeyShare) (err error) {
+	return share3.AggregateCtx(ctx, p.GetRLWEParameters(), share1, share2)
+}
+
 // Finalize finalizes the protocol and populates the input computed collective [rlwe.EvaluationKey].
 func (p EvaluationKeyProtocol) Finalize(share *EvaluationKeyShare, evk *rlwe.EvaluationKey) (err error) {
 	return share.Get(p.GetRLWEParameters(), evk)
@@ -78,6 +85,26 @@ func (share *EvaluationKeyShare) Aggregate(params rlwe.ParameterProvider, a, b *
 	return share.Vector[0].Aggregate(p.RingQ(), p.RingP(), &a.Vector[0], &b.Vector[0])
 }
 
+// AggregateCtx is the context-aware variant of [EvaluationKeyShare.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (share *EvaluationKeyShare) AggregateCtx(ctx context.Context, params rlwe.ParameterProvider, a, b *EvaluationKeyShare) (err error) {
+
+	if a.Seed != b.Seed {
+		return fmt.Errorf("shares seed do not match")
+	}
+
+	if a.DigitDecomposition != b.DigitDecomposition {
+		return fmt.Errorf("shares digit decomposition do not match")
+	}
+
+	share.Seed = a.Seed
+	share.DigitDecomposition = a.DigitDecomposition
+
+	p := params.GetRLWEParameters()
+
+	return aggregateMatrixCtx(ctx, p.RingQ(), p.RingP(), &a.Vector[0], &b.Vector[0], &share.Vector[0])
+}
+
 // Get copies the data of the receiver on a pre-allocated [rlwe.EvaluationKey].
 func (share EvaluationKeyShare) Get(params rlwe.ParameterProvider, evk *rlwe.EvaluationKey) (err error) {
 	return share.GadgetCiphertextShare.Get(params, &evk.GadgetCiphertext)
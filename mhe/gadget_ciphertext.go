@@ -2,6 +2,7 @@ package mhe
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"slices"
@@ -45,6 +46,12 @@ func (p GadgetCiphertextProtocol) Aggregate(share1, share2, share3 *GadgetCipher
 	return share3.Aggregate(p.GetRLWEParameters(), share1, share2)
 }
 
+// AggregateCtx is the context-aware variant of [GadgetCiphertextProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p GadgetCiphertextProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *GadgetCiphertextShare) (err error) {
+	return share3.AggregateCtx(ctx, p.GetRLWEParameters(), share1, share2)
+}
+
 // Finalize finalizes the protocol and populates the input computed collective [rlwe.GadgetCiphertext].
 func (p GadgetCiphertextProtocol) Finalize(share *GadgetCiphertextShare, ct *rlwe.GadgetCiphertext) (err error) {
 	return share.Get(p.GetRLWEParameters(), ct)
@@ -79,6 +86,26 @@ func (share *GadgetCiphertextShare) Aggregate(params rlwe.ParameterProvider, a,
 	return share.Vector[0].Aggregate(p.RingQ(), p.RingP(), &a.Vector[0], &b.Vector[0])
 }
 
+// AggregateCtx is the context-aware variant of [GadgetCiphertextShare.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (share *GadgetCiphertextShare) AggregateCtx(ctx context.Context, params rlwe.ParameterProvider, a, b *GadgetCiphertextShare) (err error) {
+
+	if a.Seed != b.Seed {
+		return fmt.Errorf("shares seed do not match")
+	}
+
+	if a.DigitDecomposition != b.DigitDecomposition {
+		return fmt.Errorf("shares digit decomposition do not match")
+	}
+
+	share.Seed = a.Seed
+	share.DigitDecomposition = a.DigitDecomposition
+
+	p := params.GetRLWEParameters()
+
+	return aggregateMatrixCtx(ctx, p.RingQ(), p.RingP(), &a.Vector[0], &b.Vector[0], &share.Vector[0])
+}
+
 // Get copies the data of the receiver on a pre-allocated [rlwe.GadgetCiphertext].
 func (share GadgetCiphertextShare) Get(params rlwe.ParameterProvider, gct *rlwe.GadgetCiphertext) (err error) {
 
@@ -2,6 +2,7 @@ package mhe
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 
@@ -73,6 +74,19 @@ func (p GaloisKeyProtocol) Aggregate(share1, share2, share3 *GaloisKeyShare) (er
 	return p.EvaluationKeyProtocol.Aggregate(&share1.EvaluationKeyShare, &share2.EvaluationKeyShare, &share3.EvaluationKeyShare)
 }
 
+// AggregateCtx is the context-aware variant of [GaloisKeyProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p GaloisKeyProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *GaloisKeyShare) (err error) {
+
+	if share1.GaloisElement != share2.GaloisElement {
+		return fmt.Errorf("share Galois elements do not match")
+	}
+
+	share3.GaloisElement = share1.GaloisElement
+
+	return p.EvaluationKeyProtocol.AggregateCtx(ctx, &share1.EvaluationKeyShare, &share2.EvaluationKeyShare, &share3.EvaluationKeyShare)
+}
+
 // FinalizeNew finalizes the GaloisKey Generation and returns a new [rlwe.GaloisKey].
 func (p GaloisKeyProtocol) FinalizeNew(share *GaloisKeyShare) (gk *rlwe.GaloisKey) {
 	gk = new(rlwe.GaloisKey)
@@ -1,7 +1,9 @@
 package mhe
 
 import (
+	"context"
 	"fmt"
+	"math"
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -95,6 +97,23 @@ func (p KeySwitchingProtocol[T]) Gen(skIn *rlwe.SecretKey, keyOut *T, noise floa
 	return
 }
 
+// GenWithSmudgingNoiseLog2 computes a party's public aggregatable transcript (share) as
+// [KeySwitchingProtocol.Gen], with the smudging noise expressed in bits: the share is
+// generated with a flooding noise of standard deviation 2^{smudgingNoiseLog2}. The method
+// validates the provided value against the circuit-privacy requirement and returns an error
+// if it is smaller than [MinimumSmudgingNoise](params, inputNoiseLog2), where inputNoiseLog2
+// is a caller-provided bound (in bits) on the standard deviation of the noise of ct.
+func (p KeySwitchingProtocol[T]) GenWithSmudgingNoiseLog2(skIn *rlwe.SecretKey, keyOut *T, smudgingNoiseLog2, inputNoiseLog2 float64, ct *rlwe.Ciphertext, share *KeySwitchingShare) (err error) {
+
+	noise := math.Exp2(smudgingNoiseLog2)
+
+	if minimum := MinimumSmudgingNoise(*p.GetRLWEParameters(), inputNoiseLog2); noise < minimum {
+		return fmt.Errorf("invalid smudging noise: 2^{%.2f} is smaller than MinimumSmudgingNoise(params, %.2f) = 2^{%.2f} required for circuit privacy", smudgingNoiseLog2, inputNoiseLog2, math.Log2(minimum))
+	}
+
+	return p.Gen(skIn, keyOut, noise, ct, share)
+}
+
 // Aggregate aggregates the public aggregatable transcripts: share3 = share1 + share2.
 func (p KeySwitchingProtocol[T]) Aggregate(share1, share2, share3 *KeySwitchingShare) (err error) {
 	if share1.Seed != share2.Seed {
@@ -104,6 +123,16 @@ func (p KeySwitchingProtocol[T]) Aggregate(share1, share2, share3 *KeySwitchingS
 	return share3.Vector.Aggregate(p.GetRLWEParameters().RingQ(), p.GetRLWEParameters().RingP(), &share1.Vector, &share2.Vector)
 }
 
+// AggregateCtx is the context-aware variant of [KeySwitchingProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p KeySwitchingProtocol[T]) AggregateCtx(ctx context.Context, share1, share2, share3 *KeySwitchingShare) (err error) {
+	if share1.Seed != share2.Seed {
+		return fmt.Errorf("shares seed do not match")
+	}
+	share3.Seed = share1.Seed
+	return aggregateVectorCtx(ctx, p.GetRLWEParameters().RingQ(), p.GetRLWEParameters().RingP(), &share1.Vector, &share2.Vector, &share3.Vector)
+}
+
 // FinalizeNew takes the public aggregated transcripts and peforms an oblivious re-encryption of in and returns the result in out.
 func (p KeySwitchingProtocol[T]) FinalizeNew(in *rlwe.Ciphertext, share *KeySwitchingShare) (out *rlwe.Ciphertext, err error) {
 	out = rlwe.NewCiphertext(p.GetRLWEParameters(), 1, min(in.Level(), share.Level()), -1)
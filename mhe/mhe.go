@@ -1,3 +1,19 @@
 // Package mhe implements RLWE-based scheme agnostic multiparty key-generation and proxy re-rencryption.
 // See README.md for more details about multiparty homomorphic encryption.
 package mhe
+
+import (
+	"io"
+)
+
+// The protocol shares implement the io.WriterTo and io.ReaderFrom interfaces,
+// enabling an aggregator to stream shares directly from the network into a
+// pre-allocated share without an intermediate full-size byte slice.
+var (
+	_ io.WriterTo   = &PublicKeyShare{}
+	_ io.ReaderFrom = &PublicKeyShare{}
+	_ io.WriterTo   = &GaloisKeyShare{}
+	_ io.ReaderFrom = &GaloisKeyShare{}
+	_ io.WriterTo   = &RelinearizationKeyShare{}
+	_ io.ReaderFrom = &RelinearizationKeyShare{}
+)
@@ -1,9 +1,11 @@
 package mhe
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"math"
 	"runtime"
 	"slices"
@@ -94,8 +96,10 @@ func TestMHE(t *testing.T) {
 			tc := newTestContext(params)
 
 			testPublicKeyProtocol(tc, params.MaxLevelQ(), params.MaxLevelP(), dd, t)
+			testShareStreaming(tc, t)
 			testThreshold(tc, params.MaxLevelQ(), params.MaxLevelP(), dd, t)
 			testRefreshShare(tc, params.MaxLevelQ(), params.MaxLevelP(), dd, t)
+			testEncToShareProtocol(tc, params.MaxLevelQ(), params.MaxLevelP(), dd, t)
 			testCircularGadgetCiphertextProtocol(tc, dd, t)
 			testCircularCiphertextProtocol(tc, dd, t)
 
@@ -314,10 +318,15 @@ func testPublicKeyProtocol(tc *testContext, LevelQ, LevelP int, dd rlwe.DigitDec
 
 		for i := range P {
 			if i != 0 {
-				require.NoError(t, P[0].Aggregate(P[0].share, P[i].share, P[0].share))
+				require.NoError(t, P[0].AggregateCtx(context.Background(), P[0].share, P[i].share, P[0].share))
 			}
 		}
 
+		// Aggregation with a cancelled context must abort with ctx.Err()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, P[0].AggregateCtx(ctx, P[0].share, P[1].share, P[0].share), context.Canceled)
+
 		pk := rlwe.NewPublicKey(params)
 		require.NoError(t, P[0].Finalize(P[0].share, pk))
 
@@ -620,6 +629,102 @@ func testKeySwitchProtocol(tc *testContext, LevelQ, LevelP int, dd rlwe.DigitDec
 		require.GreaterOrEqual(t, math.Log2(NoiseKeySwitch(nbParties, params.NoiseFreshSK(), params.NoiseFreshSK(), sigmaSmudging))+1, rQ.Stats(pt.Q)[0])
 	})
 
+	t.Run(testString(params, "KeySwitchingProtocol/SmudgingNoise", LevelQ, LevelP, dd), func(t *testing.T) {
+
+		p := NewKeySwitchingProtocol[rlwe.SecretKey](params)
+
+		ct := rlwe.NewCiphertext(params, 1, LevelQ, -1)
+		require.NoError(t, rlwe.NewEncryptor(params, tc.skIdeal).EncryptZero(ct))
+
+		share := p.Allocate(ct.Level())
+		skOut := tc.kgen.GenSecretKeyNew()
+
+		inputNoiseLog2 := math.Log2(params.NoiseFreshSK())
+
+		// The minimum provides the statistical circuit-privacy margin over the input noise
+		minimum := MinimumSmudgingNoise(params, inputNoiseLog2)
+		require.Equal(t, math.Exp2(inputNoiseLog2+SmudgingStatisticalSecurity+float64(params.LogN())), minimum)
+
+		// A smudging noise below the minimum must be rejected
+		require.Error(t, p.GenWithSmudgingNoiseLog2(tc.skShares[0], skOut, math.Log2(minimum)-1, inputNoiseLog2, ct, share))
+
+		require.NoError(t, p.GenWithSmudgingNoiseLog2(tc.skShares[0], skOut, math.Log2(minimum), inputNoiseLog2, ct, share))
+	})
+
+	t.Run(testString(params, "ParameterSwitchingProtocol", LevelQ, LevelP, dd), func(t *testing.T) {
+
+		// Output parameters with half the ring degree and a truncated moduli chain
+		paramsOut, err := rlwe.NewParametersFromLiteral(rlwe.ParametersLiteral{
+			LogN:     params.LogN() - 1,
+			Q:        params.Q()[:LevelQ+1],
+			RingType: params.RingType(),
+			NTTFlag:  params.NTTFlag(),
+		})
+		require.NoError(t, err)
+
+		type Party struct {
+			ParameterSwitchingProtocol
+			share *KeySwitchingShare
+			skOut *rlwe.SecretKey
+		}
+
+		P := make([]*Party, nbParties)
+
+		kgenOut := rlwe.NewKeyGenerator(paramsOut)
+
+		ct := rlwe.NewCiphertext(params, 1, LevelQ, -1)
+		require.NoError(t, rlwe.NewEncryptor(params, tc.skIdeal).EncryptZero(ct))
+
+		sigmaSmudging := 8.0 * rlwe.DefaultNoise
+
+		for i := range P {
+
+			party := &Party{}
+
+			if i == 0 {
+				p, err := NewParameterSwitchingProtocol(params, paramsOut)
+				require.NoError(t, err)
+				party.ParameterSwitchingProtocol = *p
+			} else {
+				party.ParameterSwitchingProtocol = *P[0].ShallowCopy()
+			}
+
+			party.share = party.Allocate(min(ct.Level(), paramsOut.MaxLevel()))
+			party.skOut = kgenOut.GenSecretKeyNew()
+
+			require.NoError(t, party.Gen(tc.skShares[i], party.skOut, sigmaSmudging, ct, party.share))
+
+			P[i] = party
+		}
+
+		for i := range P {
+			if i != 0 {
+				require.NoError(t, P[0].Aggregate(P[0].share, P[i].share, P[0].share))
+			}
+		}
+
+		ctOut, err := P[0].FinalizeNew(ct, P[0].share)
+		require.NoError(t, err)
+
+		require.Equal(t, paramsOut.N(), ctOut.N())
+
+		skOutIdeal := rlwe.NewSecretKey(paramsOut)
+		rQOut := paramsOut.RingQ().AtLevel(ctOut.Level())
+		for i := range P {
+			rQOut.Add(skOutIdeal.Q, P[i].skOut.Q, skOutIdeal.Q)
+		}
+
+		pt := rlwe.NewPlaintext(paramsOut, ctOut.Level(), -1)
+
+		rlwe.NewDecryptor(paramsOut, skOutIdeal).Decrypt(ctOut, pt)
+
+		if pt.IsNTT {
+			rQOut.INTT(pt.Q, pt.Q)
+		}
+
+		require.GreaterOrEqual(t, math.Log2(NoiseKeySwitch(nbParties, params.NoiseFreshSK(), params.NoiseFreshSK(), sigmaSmudging))+1, rQOut.Stats(pt.Q)[0])
+	})
+
 	t.Run(testString(params, "KeySwitchingProtocol/Pk", LevelQ, LevelP, dd), func(t *testing.T) {
 
 		type Party struct {
@@ -762,6 +867,22 @@ func testThreshold(tc *testContext, LevelQ, LevelP int, dd rlwe.DigitDecompositi
 			}
 
 			require.True(t, tc.skIdeal.Equal(recSk)) // reconstructed key should match the ideal sk
+
+			// Finalizing a second time with the same active set reuses the cached
+			// Lagrange coefficient product and must yield the same share
+			p0 := activeParties[0]
+			tsk2 := rlwe.NewSecretKey(tc.params)
+			require.NoError(t, p0.Combiner.Finalize(activeShamirPks, p0.tpk, p0.share, tsk2))
+			require.True(t, p0.tsk.Equal(tsk2))
+
+			// The exported helper returns the same per-point factors as those
+			// precomputed by the Combiner, thus the same product
+			prod := rQ.NewRNSScalarFromUInt64(1)
+			rQ.MFormRNSScalar(prod, prod)
+			for _, coeff := range LagrangeCoefficients(rQ, activeShamirPks, p0.tpk) {
+				rQ.MulRNSScalar(prod, coeff, prod)
+			}
+			require.Equal(t, ring.RNSScalar(prod), p0.Combiner.cachedProd[0])
 		})
 	}
 }
@@ -776,3 +897,157 @@ func testRefreshShare(tc *testContext, LevelQ, LevelP int, dd rlwe.DigitDecompos
 		buffer.RequireSerializerCorrect(t, &RefreshShare{EncToShareShare: p0, ShareToEncShare: p1, MetaData: rlwe.MetaData{IsNTT: true}})
 	})
 }
+
+func testEncToShareProtocol(tc *testContext, LevelQ, LevelP int, dd rlwe.DigitDecomposition, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(testString(params, "EncToShareProtocol", LevelQ, LevelP, dd), func(t *testing.T) {
+
+		type Party struct {
+			e2s            EncToShareProtocol
+			s2e            ShareToEncProtocol
+			sk             *rlwe.SecretKey
+			publicShareE2S *KeySwitchingShare
+			publicShareS2E *KeySwitchingShare
+			secretShare    *AdditiveShareBigint
+		}
+
+		rQ := params.RingQ().AtLevel(LevelQ)
+
+		// Shares the full coefficient vector of the ciphertext
+		logSlots := params.LogN() - 1
+		if params.RingType() == ring.ConjugateInvariant {
+			logSlots = params.LogN()
+		}
+
+		ct := rlwe.NewCiphertext(params, 1, LevelQ, -1)
+		ct.LogDimensions = ring.Dimensions{Rows: 0, Cols: logSlots}
+		require.NoError(t, rlwe.NewEncryptor(params, tc.skIdeal).EncryptZero(ct))
+
+		dslots := ct.Slots()
+		if params.RingType() == ring.Standard {
+			dslots *= 2
+		}
+
+		// Masks must be re-combinable without wrapping around Q
+		logBound := uint(rQ.Modulus().BitLen() - 10)
+
+		P := make([]Party, tc.nParties())
+		for i := range P {
+
+			if i == 0 {
+				P[i].e2s = *NewEncToShareProtocol(params)
+				P[i].s2e = *NewShareToEncProtocol(params)
+			} else {
+				P[i].e2s = *P[0].e2s.ShallowCopy()
+				P[i].s2e = *P[0].s2e.ShallowCopy()
+			}
+
+			P[i].sk = tc.skShares[i]
+			P[i].publicShareE2S = P[i].e2s.Allocate(LevelQ)
+			P[i].publicShareS2E = P[i].s2e.Allocate(LevelQ)
+			P[i].secretShare = NewAdditiveShareBigint(dslots)
+		}
+
+		for i, p := range P {
+			// Enc(-M_i)
+			require.NoError(t, p.e2s.Gen(p.sk, logBound, ct, p.secretShare, p.publicShareE2S))
+
+			if i > 0 {
+				// Enc(sum(-M_i))
+				require.NoError(t, p.e2s.Aggregate(P[0].publicShareE2S, p.publicShareE2S, P[0].publicShareE2S))
+			}
+		}
+
+		// sum(-M_i) + x
+		require.NoError(t, P[0].e2s.Finalize(P[0].secretShare, P[0].publicShareE2S, ct, P[0].secretShare))
+
+		// sum(-M_i) + x + sum(M_i) = x
+		rec := NewAdditiveShareBigint(dslots)
+		for i := range P {
+			a := rec.Value
+			b := P[i].secretShare.Value
+			for j := range a {
+				a[j].Add(&a[j], &b[j])
+			}
+		}
+
+		// The reconstructed shares must decrypt the ciphertext
+		pt := rlwe.NewPlaintext(params, LevelQ, -1)
+		pt.IsNTT = false
+		rQ.SetCoefficientsBigint(rec.Value, pt.Q)
+
+		noiseE2S := NoiseKeySwitch(tc.nParties(), params.NoiseFreshSK(), params.NoiseFreshSK(), 0)
+
+		require.GreaterOrEqual(t, math.Log2(noiseE2S)+1, rQ.Stats(pt.Q)[0])
+
+		seed := [32]byte{}
+
+		for i, p := range P {
+			require.NoError(t, p.s2e.Gen(p.sk, seed, ct.MetaData, p.secretShare, p.publicShareS2E))
+			if i > 0 {
+				require.NoError(t, p.s2e.Aggregate(P[0].publicShareS2E, p.publicShareS2E, P[0].publicShareS2E))
+			}
+		}
+
+		ctRec := rlwe.NewCiphertext(params, 1, LevelQ, -1)
+		ctRec.LogDimensions = ct.LogDimensions
+		require.NoError(t, P[0].s2e.Finalize(P[0].publicShareS2E, ctRec))
+
+		rlwe.NewDecryptor(params, tc.skIdeal).Decrypt(ctRec, pt)
+
+		if pt.IsNTT {
+			rQ.INTT(pt.Q, pt.Q)
+		}
+
+		noise := math.Sqrt(noiseE2S*noiseE2S + float64(tc.nParties())*params.NoiseFreshSK()*params.NoiseFreshSK())
+
+		require.GreaterOrEqual(t, math.Log2(noise)+1, rQ.Stats(pt.Q)[0])
+	})
+}
+
+func testShareStreaming(tc *testContext, t *testing.T) {
+
+	params := tc.params
+
+	// Checks that the shares can be streamed through a raw io.Writer/io.Reader
+	// (e.g. a network connection) without an intermediate full-size byte slice.
+	t.Run(testString(params, "ShareStreaming", params.MaxLevelQ(), params.MaxLevelP(), rlwe.DigitDecomposition{}), func(t *testing.T) {
+
+		seed := [32]byte{0xFF}
+		sk := tc.skShares[0]
+
+		stream := func(out io.WriterTo, in io.ReaderFrom) {
+			r, w := io.Pipe()
+			go func() {
+				_, err := out.WriteTo(w)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+			}()
+			_, err := in.ReadFrom(r)
+			require.NoError(t, err)
+		}
+
+		pkProto := NewPublicKeyProtocol(params)
+		pkShare := pkProto.Allocate()
+		require.NoError(t, pkProto.Gen(sk, seed, pkShare))
+		pkShareOut := pkProto.Allocate()
+		stream(pkShare, pkShareOut)
+		require.True(t, pkShare.Equal(&pkShareOut.VectorShare))
+
+		gkProto := NewGaloisKeyProtocol(params)
+		gkShare := gkProto.Allocate()
+		require.NoError(t, gkProto.Gen(sk, params.GaloisElement(1), seed, gkShare))
+		gkShareOut := gkProto.Allocate()
+		stream(gkShare, gkShareOut)
+		require.True(t, gkShare.Equal(gkShareOut))
+
+		rkProto := NewRelinearizationKeyProtocol(params)
+		rkShare := rkProto.Allocate()
+		require.NoError(t, rkProto.Gen(sk, tc.kgen.GenPublicKeyNew(tc.skIdeal), rkShare))
+		rkShareOut := rkProto.Allocate()
+		stream(rkShare, rkShareOut)
+		require.True(t, rkShare.Equal(&rkShareOut.GadgetCiphertext))
+	})
+}
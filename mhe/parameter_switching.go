@@ -0,0 +1,147 @@
+package mhe
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/rlwe"
+)
+
+// ParameterSwitchingProtocol is the structure storing the parameters and precomputations for
+// the collective key-switching protocol variant that additionally switches the ciphertext to
+// a different parameter set: the output ciphertext is re-encrypted under a collective
+// secret-key defined in the output parameters, whose ring degree can be smaller and whose
+// modulus chain can be a truncation of the one of the input parameters.
+//
+// This enables compressing a result before sending it to a thin client that only operates
+// with the smaller parameters.
+type ParameterSwitchingProtocol struct {
+	KeySwitchingProtocol[rlwe.SecretKey]
+	paramsOut rlwe.Parameters
+	skOutIn   *rlwe.SecretKey // output key embedded in the input ring
+	buffQ     ring.RNSPoly
+	buffNTT   []uint64
+}
+
+// NewParameterSwitchingProtocol creates a new ParameterSwitchingProtocol, that will be used to
+// perform a collective key-switching on a ciphertext encrypted under a collective secret-key in
+// paramsIn, whose secret-shares are distributed among j parties, re-encrypting the ciphertext
+// under a collective secret-key defined in paramsOut.
+//
+// The method returns an error if:
+//   - The ring degree of paramsOut is larger than the one of paramsIn
+//   - The ring types of paramsIn and paramsOut do not match
+//   - The moduli Q of paramsOut are not a prefix of the moduli Q of paramsIn
+func NewParameterSwitchingProtocol(paramsIn, paramsOut rlwe.ParameterProvider) (*ParameterSwitchingProtocol, error) {
+
+	pIn := *paramsIn.GetRLWEParameters()
+	pOut := *paramsOut.GetRLWEParameters()
+
+	if pOut.N() > pIn.N() {
+		return nil, fmt.Errorf("output parameters ring degree (%d) cannot be larger than input parameters ring degree (%d)", pOut.N(), pIn.N())
+	}
+
+	if pOut.RingType() != pIn.RingType() {
+		return nil, fmt.Errorf("input and output parameters ring types do not match")
+	}
+
+	qIn, qOut := pIn.Q(), pOut.Q()
+
+	if len(qOut) > len(qIn) {
+		return nil, fmt.Errorf("output parameters moduli chain length (%d) cannot be larger than input parameters moduli chain length (%d)", len(qOut), len(qIn))
+	}
+
+	for i := range qOut {
+		if qOut[i] != qIn[i] {
+			return nil, fmt.Errorf("output parameters moduli Q must be a prefix of the input parameters moduli Q")
+		}
+	}
+
+	return &ParameterSwitchingProtocol{
+		KeySwitchingProtocol: *NewKeySwitchingProtocol[rlwe.SecretKey](paramsIn),
+		paramsOut:            pOut,
+		skOutIn:              rlwe.NewSecretKey(pIn),
+		buffQ:                pIn.RingQAtLevel(pOut.MaxLevelQ()).NewRNSPoly(),
+		buffNTT:              make([]uint64, pIn.N()),
+	}, nil
+}
+
+// ShallowCopy creates a shallow copy of ParameterSwitchingProtocol in which all the read-only
+// data-structures are shared with the receiver and the temporary buffers are reallocated. The
+// receiver and the returned ParameterSwitchingProtocol can be used concurrently.
+func (p ParameterSwitchingProtocol) ShallowCopy() *ParameterSwitchingProtocol {
+	pIn := p.GetRLWEParameters()
+	return &ParameterSwitchingProtocol{
+		KeySwitchingProtocol: *p.KeySwitchingProtocol.ShallowCopy(),
+		paramsOut:            p.paramsOut,
+		skOutIn:              rlwe.NewSecretKey(pIn),
+		buffQ:                pIn.RingQAtLevel(p.paramsOut.MaxLevelQ()).NewRNSPoly(),
+		buffNTT:              make([]uint64, pIn.N()),
+	}
+}
+
+// Gen computes a party's public aggregatable transcript (share) in the ParameterSwitchingProtocol.
+// skIn is the party's share of the input collective secret-key, defined in the input parameters.
+// skOut is the party's share of the output collective secret-key, defined in the output parameters.
+// ct is the rlwe.Ciphertext to key-switch. Note that ct.Q[0] is not used by the function and can be nil/zero.
+// The share must be allocated at a level at most paramsOut.MaxLevel().
+func (p ParameterSwitchingProtocol) Gen(skIn, skOut *rlwe.SecretKey, noise float64, ct *rlwe.Ciphertext, share *KeySwitchingShare) (err error) {
+
+	if skOut.N() != p.paramsOut.N() {
+		return fmt.Errorf("skOut ring degree (%d) does not match the output parameters ring degree (%d)", skOut.N(), p.paramsOut.N())
+	}
+
+	if share.LevelQ() > p.paramsOut.MaxLevelQ() {
+		return fmt.Errorf("share level (%d) cannot be larger than the output parameters maximum level (%d)", share.LevelQ(), p.paramsOut.MaxLevelQ())
+	}
+
+	level := min(skOut.LevelQ(), share.LevelQ())
+
+	// Maps skOut to the input ring degree with Y = X^{N/n}
+	p.GetRLWEParameters().RingQ().AtLevel(level).SwitchRingDegreeNTT(skOut.Q, p.buffNTT, p.skOutIn.Q)
+
+	return p.KeySwitchingProtocol.Gen(skIn, p.skOutIn, noise, ct, share)
+}
+
+// FinalizeNew takes the public aggregated transcripts and performs an oblivious re-encryption
+// of in, returning in a newly allocated ciphertext defined in the output parameters.
+func (p ParameterSwitchingProtocol) FinalizeNew(in *rlwe.Ciphertext, share *KeySwitchingShare) (out *rlwe.Ciphertext, err error) {
+	out = rlwe.NewCiphertext(&p.paramsOut, 1, min(min(in.Level(), share.Level()), p.paramsOut.MaxLevel()), -1)
+	return out, p.Finalize(in, share, out)
+}
+
+// Finalize takes the public aggregated transcripts and performs an oblivious re-encryption of in,
+// returning in out a ciphertext defined in the output parameters, encrypted under the collective
+// secret-key whose shares were provided to [ParameterSwitchingProtocol.Gen].
+//
+// If the output ring degree n is smaller than the input ring degree N, the plaintext is mapped
+// with X -> Y = X^{N/n}, i.e. only the plaintext coefficients whose index is a multiple of N/n
+// are retained.
+func (p ParameterSwitchingProtocol) Finalize(in *rlwe.Ciphertext, share *KeySwitchingShare, out *rlwe.Ciphertext) (err error) {
+
+	if out.N() != p.paramsOut.N() {
+		return fmt.Errorf("out ring degree (%d) does not match the output parameters ring degree (%d)", out.N(), p.paramsOut.N())
+	}
+
+	if share.Size() != 1 {
+		return fmt.Errorf("invalid share: should be of size 1 but is of size %d", share.Size())
+	}
+
+	level := min(min(in.Level(), share.Level()), out.Level())
+	out.ResizeQ(level)
+
+	rQ := p.GetRLWEParameters().RingQ().AtLevel(level)
+
+	rQ.Add(in.Q[0], share.Q[0], p.buffQ)
+
+	ctTmp := &rlwe.Ciphertext{}
+	ctTmp.Vector = &ring.Vector{Q: []ring.RNSPoly{p.buffQ, in.Q[1]}}
+	ctTmp.MetaData = in.MetaData
+
+	// Maps the ciphertext to the output ring degree with X -> Y = X^{N/n}
+	ctTmp.SwitchRingDegree(rQ, nil, p.buffNTT, out)
+
+	*out.MetaData = *in.MetaData
+
+	return
+}
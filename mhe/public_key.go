@@ -1,6 +1,8 @@
 package mhe
 
 import (
+	"context"
+
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
 	"github.com/Pro7ech/lattigo/utils/sampling"
@@ -52,6 +54,12 @@ func (p PublicKeyProtocol) Aggregate(share1, share2, share3 *PublicKeyShare) (er
 	return share3.Aggregate(p.GetRLWEParameters(), &share1.VectorShare, &share2.VectorShare)
 }
 
+// AggregateCtx is the context-aware variant of [PublicKeyProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p PublicKeyProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *PublicKeyShare) (err error) {
+	return share3.AggregateCtx(ctx, p.GetRLWEParameters(), &share1.VectorShare, &share2.VectorShare)
+}
+
 // Finalize return the current aggregation of the received shares as a bfv.PublicKey.
 func (p PublicKeyProtocol) Finalize(share *PublicKeyShare, pubkey *rlwe.PublicKey) (err error) {
 	params := p.GetRLWEParameters()
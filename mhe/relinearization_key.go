@@ -1,6 +1,8 @@
 package mhe
 
 import (
+	"context"
+
 	"github.com/Pro7ech/lattigo/rlwe"
 )
 
@@ -49,6 +51,16 @@ func (p RelinearizationKeyProtocol) Aggregate(share1, share2, share3 *Relineariz
 	return share3.Vector[1].Aggregate(params.RingQ(), params.RingP(), &share1.Vector[1], &share2.Vector[1])
 }
 
+// AggregateCtx is the context-aware variant of [RelinearizationKeyProtocol.Aggregate]. It checks for
+// the cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done.
+func (p RelinearizationKeyProtocol) AggregateCtx(ctx context.Context, share1, share2, share3 *RelinearizationKeyShare) (err error) {
+	params := p.GetRLWEParameters()
+	if err = aggregateMatrixCtx(ctx, params.RingQ(), params.RingP(), &share1.Vector[0], &share2.Vector[0], &share3.Vector[0]); err != nil {
+		return
+	}
+	return aggregateMatrixCtx(ctx, params.RingQ(), params.RingP(), &share1.Vector[1], &share2.Vector[1], &share3.Vector[1])
+}
+
 // Finalize finalizes the protocol and populates the input computed collective RelinearizationKey.
 func (p RelinearizationKeyProtocol) Finalize(share *RelinearizationKeyShare, evk *rlwe.RelinearizationKey) (err error) {
 	evk.Copy(share.Vector)
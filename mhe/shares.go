@@ -2,8 +2,10 @@ package mhe
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -30,6 +32,120 @@ func (share VectorShare) Aggregate(params rlwe.ParameterProvider, share1, share2
 	return share.Vector.Aggregate(p.RingQ(), p.RingP(), &share1.Vector, &share2.Vector)
 }
 
+// AggregateCtx is the context-aware variant of [VectorShare.Aggregate]. It checks for the
+// cancellation of ctx between each ring operation and aborts with ctx.Err() if ctx is done,
+// leaving the receiver in an undefined state.
+func (share VectorShare) AggregateCtx(ctx context.Context, params rlwe.ParameterProvider, share1, share2 *VectorShare) (err error) {
+
+	if share1.Seed != share2.Seed {
+		return fmt.Errorf("shares seed do not match")
+	}
+	p := params.GetRLWEParameters()
+	return aggregateVectorCtx(ctx, p.RingQ(), p.RingP(), &share1.Vector, &share2.Vector, &share.Vector)
+}
+
+// aggregateVectorCtx evaluates v = a + b, mirroring [ring.Vector.Aggregate], except that it
+// checks for the cancellation of ctx between each ring operation and aborts with ctx.Err()
+// if ctx is done.
+func aggregateVectorCtx(ctx context.Context, rQ, rP ring.RNSRing, a, b, v *ring.Vector) (err error) {
+
+	LevelQ := v.LevelQ()
+	LevelP := v.LevelP()
+
+	if a.LevelQ() != LevelQ || b.LevelQ() != LevelQ {
+		return fmt.Errorf("vectors LevelQ do not match: %d <- %d + %d", LevelQ, a.LevelQ(), b.LevelQ())
+	}
+
+	if a.LevelP() != LevelP || b.LevelP() != LevelP {
+		return fmt.Errorf("vectors LevelP do not match: %d <- %d + %d", LevelP, a.LevelP(), b.LevelP())
+	}
+
+	size := v.Size()
+
+	if a.Size() != size || b.Size() != size {
+		return fmt.Errorf("vectors size do not match: %v <- %v + %v", size, a.Size(), b.Size())
+	}
+
+	rQ = rQ.AtLevel(LevelQ)
+
+	if rP != nil && LevelP > -1 {
+		rP = rP.AtLevel(LevelP)
+	}
+
+	for i := 0; i < size; i++ {
+
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		rQ.Add(a.Q[i], b.Q[i], v.Q[i])
+
+		if LevelP > -1 {
+
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			rP.Add(a.P[i], b.P[i], v.P[i])
+		}
+	}
+
+	return
+}
+
+// aggregateMatrixCtx evaluates m = a + b, mirroring [ring.Matrix.Aggregate], except that it
+// checks for the cancellation of ctx between each ring operation and aborts with ctx.Err()
+// if ctx is done.
+func aggregateMatrixCtx(ctx context.Context, rQ, rP ring.RNSRing, a, b, m *ring.Matrix) (err error) {
+
+	LevelQ := m.LevelQ()
+	LevelP := m.LevelP()
+
+	if a.LevelQ() != LevelQ || b.LevelQ() != LevelQ {
+		return fmt.Errorf("matrices LevelQ do not match: %d <- %d + %d", LevelQ, a.LevelQ(), b.LevelQ())
+	}
+
+	if a.LevelP() != LevelP || b.LevelP() != LevelP {
+		return fmt.Errorf("matrices LevelP do not match: %d <- %d + %d", LevelP, a.LevelP(), b.LevelP())
+	}
+
+	dims := m.Dims()
+
+	if !slices.Equal(a.Dims(), dims) || !slices.Equal(b.Dims(), dims) {
+		return fmt.Errorf("matrices dimensions do not match: %v <- %v + %v", dims, a.Dims(), b.Dims())
+	}
+
+	rQ = rQ.AtLevel(LevelQ)
+
+	if rP != nil && LevelP > -1 {
+		rP = rP.AtLevel(LevelP)
+	}
+
+	rows := len(dims)
+
+	for i := range rows {
+		for j := range dims[i] {
+
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			rQ.Add(a.Q[i][j], b.Q[i][j], m.Q[i][j])
+
+			if LevelP > -1 {
+
+				if err = ctx.Err(); err != nil {
+					return
+				}
+
+				rP.Add(a.P[i][j], b.P[i][j], m.P[i][j])
+			}
+		}
+	}
+
+	return
+}
+
 // Equal performs a deep equal between the receiver and the operand.
 func (share VectorShare) Equal(other *VectorShare) bool {
 	return share.Seed == other.Seed && share.Vector.Equal(&other.Vector)
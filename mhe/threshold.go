@@ -1,7 +1,9 @@
 package mhe
 
 import (
+	"context"
 	"fmt"
+	"slices"
 
 	"github.com/Pro7ech/lattigo/ring"
 	"github.com/Pro7ech/lattigo/rlwe"
@@ -80,6 +82,15 @@ func (thr Thresholdizer) Aggregate(share1, share2, share3 *ShamirSecretShare) (e
 	return share3.Point.Aggregate(thr.params.RingQ(), thr.params.RingP(), &share1.Point, &share2.Point)
 }
 
+// AggregateCtx is the context-aware variant of [Thresholdizer.Aggregate]. It checks for
+// the cancellation of ctx before the ring operations and aborts with ctx.Err() if ctx is done.
+func (thr Thresholdizer) AggregateCtx(ctx context.Context, share1, share2, share3 *ShamirSecretShare) (err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	return share3.Point.Aggregate(thr.params.RingQ(), thr.params.RingP(), &share1.Point, &share2.Point)
+}
+
 // Finalize generates a secret share for the given recipient, identified by its ShamirPublicPoint.
 // The result is stored in ShareOut and should be sent to this party.
 func (thr Thresholdizer) Finalize(recipient ShamirPublicPoint, secretPoly *ShamirPolynomial, shareOut *ShamirSecretShare) (err error) {
@@ -102,6 +113,12 @@ type Combiner struct {
 	tmp1, tmp2     [2]ring.RNSScalar
 	one            [2]ring.RNSScalar
 	lagrangeCoeffs map[ShamirPublicPoint][2]ring.RNSScalar
+
+	// cache of the product of the Lagrange coefficient factors over the
+	// last active set, reused across Finalize calls with the same set
+	cachedActive []ShamirPublicPoint
+	cachedOwn    ShamirPublicPoint
+	cachedProd   [2]ring.RNSScalar
 }
 
 // NewCombiner creates a new Combiner struct from the parameters and the set of ShamirPublicPoints. Note that the other
@@ -151,58 +168,93 @@ func NewCombiner(params rlwe.Parameters, own ShamirPublicPoint, others []ShamirP
 
 // Finalize generates a t-out-of-t additive share of the secret from a local aggregated share ownSecret and the set of active identities, identified
 // by their ShamirPublicPoint. It stores the resulting additive share in skOut.
-func (cmb Combiner) Finalize(activesPoints []ShamirPublicPoint, ownPoint ShamirPublicPoint, ownShare *ShamirSecretShare, skOut *rlwe.SecretKey) (err error) {
+//
+// The per-point Lagrange coefficient factors are precomputed at the creation of the
+// Combiner (each factor requires one RNS inversion, i.e. O(log(q)) modular
+// multiplications per modulus), so that a call to Finalize only evaluates their
+// product over the active set, i.e. threshold-1 RNS scalar multiplications, plus one
+// scalar multiplication per coefficient of the share. The product is cached and
+// reused across repeated calls with the same active set (in the same order).
+func (cmb *Combiner) Finalize(activesPoints []ShamirPublicPoint, ownPoint ShamirPublicPoint, ownShare *ShamirSecretShare, skOut *rlwe.SecretKey) (err error) {
 
 	if len(activesPoints) < cmb.threshold {
 		return fmt.Errorf("not enough active players to combine threshold shares")
 	}
 
-	prod := cmb.tmp2
-	copy(prod[0], cmb.one[0])
-	copy(prod[1], cmb.one[1])
-
 	rQ := cmb.rQ
 	rP := cmb.rP
 
-	for _, active := range activesPoints[:cmb.threshold] {
-		//Lagrange Interpolation with the public threshold key of other active players
-		if active != ownPoint {
-			rQ.MulRNSScalar(prod[0], cmb.lagrangeCoeffs[active][0], prod[0])
+	actives := activesPoints[:cmb.threshold]
+
+	if cmb.cachedProd[0] == nil || cmb.cachedOwn != ownPoint || !slices.Equal(cmb.cachedActive, actives) {
+
+		if cmb.cachedProd[0] == nil {
+			cmb.cachedProd[0] = rQ.NewRNSScalar()
 			if rP != nil {
-				rP.MulRNSScalar(prod[1], cmb.lagrangeCoeffs[active][1], prod[1])
+				cmb.cachedProd[1] = rP.NewRNSScalar()
+			}
+		}
+
+		prod := cmb.cachedProd
+		copy(prod[0], cmb.one[0])
+		copy(prod[1], cmb.one[1])
+
+		for _, active := range actives {
+			//Lagrange Interpolation with the public threshold key of other active players
+			if active != ownPoint {
+				rQ.MulRNSScalar(prod[0], cmb.lagrangeCoeffs[active][0], prod[0])
+				if rP != nil {
+					rP.MulRNSScalar(prod[1], cmb.lagrangeCoeffs[active][1], prod[1])
+				}
 			}
 		}
+
+		cmb.cachedActive = slices.Clone(actives)
+		cmb.cachedOwn = ownPoint
 	}
 
-	rQ.MulRNSScalarMontgomery(ownShare.Q, prod[0], skOut.Q)
+	rQ.MulRNSScalarMontgomery(ownShare.Q, cmb.cachedProd[0], skOut.Q)
 	if rP != nil {
-		rP.MulRNSScalarMontgomery(ownShare.P, prod[1], skOut.P)
+		rP.MulRNSScalarMontgomery(ownShare.P, cmb.cachedProd[1], skOut.P)
 	}
 
 	return
 }
 
-func (cmb Combiner) lagrangeCoeff(thisKey ShamirPublicPoint, thatKey ShamirPublicPoint, lagCoeff [2]ring.RNSScalar) {
-
-	rQ := cmb.rQ
-	rP := cmb.rP
-
-	var this, that [2]ring.RNSScalar
-
-	this[0] = rQ.NewRNSScalarFromUInt64(uint64(thisKey))
-	that[0] = rQ.NewRNSScalarFromUInt64(uint64(thatKey))
-
-	if rP != nil {
-		this[1] = rP.NewRNSScalarFromUInt64(uint64(thisKey))
-		that[1] = rP.NewRNSScalarFromUInt64(uint64(thatKey))
+// LagrangeCoefficients returns, for each point of points distinct from at, the factor
+// x/(x - at) lifted to each modulus of r, where x is the point. The product of these
+// factors over a set of active points is the Lagrange coefficient weighting, at zero,
+// the Shamir share of the party holding the point at. The factors are returned in the
+// domain expected by [ring.RNSRing.MulRNSScalar] and [ring.RNSRing.MulRNSScalarMontgomery].
+//
+// Each factor requires one RNS inversion, i.e. O(log(q)) modular multiplications per
+// modulus of r, thus for repeated reconstructions the factors should be computed once
+// per point and cached, as done by the Combiner.
+func LagrangeCoefficients(r ring.RNSRing, points []ShamirPublicPoint, at ShamirPublicPoint) (coeffs []ring.RNSScalar) {
+	coeffs = make([]ring.RNSScalar, 0, len(points))
+	for _, point := range points {
+		if point != at {
+			coeff := r.NewRNSScalar()
+			lagrangeCoefficient(r, at, point, coeff)
+			coeffs = append(coeffs, coeff)
+		}
 	}
+	return
+}
 
-	rQ.SubRNSScalar(that[0], this[0], lagCoeff[0])
-	rQ.Inverse(lagCoeff[0])
-	rQ.MulRNSScalar(lagCoeff[0], that[0], lagCoeff[0])
-	if rP != nil {
-		rP.SubRNSScalar(that[1], this[1], lagCoeff[1])
-		rP.Inverse(lagCoeff[1])
-		rP.MulRNSScalar(lagCoeff[1], that[1], lagCoeff[1])
+// lagrangeCoefficient evaluates into coeff the factor that/(that - this) lifted to
+// each modulus of r.
+func lagrangeCoefficient(r ring.RNSRing, this, that ShamirPublicPoint, coeff ring.RNSScalar) {
+	thisScalar := r.NewRNSScalarFromUInt64(uint64(this))
+	thatScalar := r.NewRNSScalarFromUInt64(uint64(that))
+	r.SubRNSScalar(thatScalar, thisScalar, coeff)
+	r.Inverse(coeff)
+	r.MulRNSScalar(coeff, thatScalar, coeff)
+}
+
+func (cmb Combiner) lagrangeCoeff(thisKey ShamirPublicPoint, thatKey ShamirPublicPoint, lagCoeff [2]ring.RNSScalar) {
+	lagrangeCoefficient(cmb.rQ, thisKey, thatKey, lagCoeff[0])
+	if cmb.rP != nil {
+		lagrangeCoefficient(cmb.rP, thisKey, thatKey, lagCoeff[1])
 	}
 }
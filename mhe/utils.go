@@ -59,6 +59,24 @@ func NoiseGaloisKey(params rlwe.Parameters, nbParties int) (std float64) {
 	return NoiseEvaluationKey(params, nbParties)
 }
 
+// SmudgingStatisticalSecurity is the default statistical security parameter (in bits) of
+// the smudging (flooding) noise of the collective key-switching protocols, i.e. the
+// statistical distance masking the input noise is at most 2^{-SmudgingStatisticalSecurity}.
+const SmudgingStatisticalSecurity = 40
+
+// MinimumSmudgingNoise returns the minimum standard deviation of the smudging (flooding)
+// noise to be used in the collective key-switching protocols for an input ciphertext whose
+// noise standard deviation is bounded by 2^{inputNoiseLog2}. The input noise depends on the
+// secret-key and on the evaluated circuit, thus the smudging noise must statistically mask
+// it for the circuit-privacy argument of the protocols to hold (see
+// https://eprint.iacr.org/2017/257). The returned value is
+// 2^{inputNoiseLog2 + SmudgingStatisticalSecurity + LogN}: a smudging ratio of
+// SmudgingStatisticalSecurity bits, with an additional LogN bits accounting for the union
+// bound over the N coefficients of the ciphertext.
+func MinimumSmudgingNoise(params rlwe.Parameters, inputNoiseLog2 float64) float64 {
+	return math.Exp2(inputNoiseLog2 + SmudgingStatisticalSecurity + float64(params.LogN()))
+}
+
 func NoiseKeySwitch(nbParties int, noisect, noisefresh, noiseflood float64) (std float64) {
 	std = noisefresh * noisefresh
 	std += noiseflood * noiseflood
@@ -3,6 +3,7 @@ package ring
 import (
 	"bufio"
 	"encoding"
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -148,6 +149,12 @@ func (d *DiscreteGaussian) UnmarshalBinary(p []byte) (err error) {
 	return
 }
 
+// MarshalJSON encodes the object into a JSON map including the distribution
+// Type, as expected by [DistributionParametersFromMap].
+func (d DiscreteGaussian) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"Type": discreteGaussianName, "Sigma": d.Sigma, "Bound": d.Bound})
+}
+
 func (d DiscreteGaussian) mustBeDist() {}
 
 func (d Ternary) Equal(other DistributionParameters) bool {
@@ -238,6 +245,19 @@ func (d *Ternary) UnmarshalBinary(p []byte) (err error) {
 	return
 }
 
+// MarshalJSON encodes the object into a JSON map including the distribution
+// Type, as expected by [DistributionParametersFromMap].
+func (d Ternary) MarshalJSON() ([]byte, error) {
+	m := map[string]interface{}{"Type": ternaryDistName}
+	if d.P != 0 {
+		m["P"] = d.P
+	}
+	if d.H != 0 {
+		m["H"] = d.H
+	}
+	return json.Marshal(m)
+}
+
 func (d Ternary) mustBeDist() {}
 
 func (d Uniform) Equal(other DistributionParameters) bool {
@@ -304,6 +324,12 @@ func (d *Uniform) UnmarshalBinary(p []byte) (err error) {
 	return
 }
 
+// MarshalJSON encodes the object into a JSON map including the distribution
+// Type, as expected by [DistributionParametersFromMap].
+func (d Uniform) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"Type": uniformDistName})
+}
+
 func (d Uniform) mustBeDist() {}
 
 func DistributionParametersFromReader(r io.Reader) (distribution DistributionParameters, n int64, err error) {
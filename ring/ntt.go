@@ -47,6 +47,14 @@ func (r Ring) NTTLazy(p1, p2 []uint64) {
 	r.ForwardLazy(p1, p2)
 }
 
+// NTTInPlace evaluates p = NTT(p). The butterflies of the underlying Cooley-Tukey
+// algorithm operate directly on p without internal buffers, thus the method performs
+// no heap allocation and requires no scratch space, making it suitable for
+// memory-constrained environments.
+func (r Ring) NTTInPlace(p []uint64) {
+	r.Forward(p, p)
+}
+
 // INTT evaluates p2 = INTT(p1).
 func (r Ring) INTT(p1, p2 []uint64) {
 	r.Backward(p1, p2)
@@ -56,3 +64,9 @@ func (r Ring) INTT(p1, p2 []uint64) {
 func (r Ring) INTTLazy(p1, p2 []uint64) {
 	r.BackwardLazy(p1, p2)
 }
+
+// INTTInPlace evaluates p = INTT(p). Like [Ring.NTTInPlace], the Gentleman-Sande
+// butterflies operate directly on p and the method performs no heap allocation.
+func (r Ring) INTTInPlace(p []uint64) {
+	r.Backward(p, p)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Pro7ech/lattigo/utils/sampling"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -117,3 +118,40 @@ func TestNTT(t *testing.T) {
 		})
 	}
 }
+
+func TestNTTInPlace(t *testing.T) {
+
+	for _, tv := range testVector[:] {
+
+		ringQ, err := NewRNSRing(tv.N, tv.Qis)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(fmt.Sprintf("N=%d/limbs=%d", ringQ.N(), ringQ.ModuliChainLength()), func(t *testing.T) {
+
+			x := NewUniformSampler(sampling.NewSource([32]byte{}), ringQ.ModuliChain()).ReadNew(ringQ.N())
+
+			y := ringQ.NewRNSPoly()
+			z := ringQ.NewRNSPoly()
+
+			z.Copy(&x)
+
+			ringQ.NTT(x, y)
+			ringQ.NTTInPlace(z)
+
+			assert.True(t, ringQ.Equal(z, y), "in-place NTT should match out-of-place NTT")
+
+			assert.Zero(t, testing.AllocsPerRun(4, func() { ringQ.NTTInPlace(z) }), "in-place NTT should not allocate")
+
+			z.Copy(&y)
+
+			ringQ.INTT(y, y)
+			ringQ.INTTInPlace(z)
+
+			assert.True(t, ringQ.Equal(z, y), "in-place invNTT should match out-of-place invNTT")
+			assert.True(t, ringQ.Equal(z, x), "in-place invNTT should reverse in-place NTT")
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package ring
+
+import (
+	"sync"
+)
+
+// RNSPolyPool is a thread-safe pool of backing arrays for [RNSPoly], backed by
+// a [sync.Pool]. It amortizes the allocation of temporary polynomials under
+// heavy concurrent load (e.g. evaluators instantiated per worker), reducing the
+// pressure on the garbage collector.
+// The zero value is not valid, the pool must be instantiated with [NewRNSPolyPool].
+type RNSPolyPool struct {
+	pool *sync.Pool
+}
+
+// NewRNSPolyPool instantiates a new RNSPolyPool.
+// A single pool can serve polynomials of any dimensions: backing arrays are
+// recycled across dimensions and grown as needed.
+func NewRNSPolyPool() *RNSPolyPool {
+	return &RNSPolyPool{
+		pool: &sync.Pool{
+			New: func() any {
+				return &[]uint64{}
+			},
+		},
+	}
+}
+
+// Get returns an [RNSPoly] with N coefficients and Level+1 moduli whose backing
+// array is drawn from the pool, allocating a new one if the pool is empty or if
+// the recycled array is too small. The coefficients of the returned polynomial
+// are zeroed.
+// The polynomial can be returned to the pool with [RNSPolyPool.Put] once it is
+// no longer used.
+func (pool *RNSPolyPool) Get(N, Level int) (p *RNSPoly) {
+
+	p = new(RNSPoly)
+
+	size := p.BufferSize(N, Level)
+
+	buf := *(pool.pool.Get().(*[]uint64))
+
+	if cap(buf) < size {
+		buf = make([]uint64, size)
+	} else {
+		buf = buf[:size]
+		clear(buf)
+	}
+
+	p.FromBuffer(N, Level, buf)
+
+	return
+}
+
+// Put returns the backing array of p to the pool.
+// The polynomial must have been instantiated by [RNSPolyPool.Get] (or share its
+// backing array layout, i.e. have been instantiated through [RNSPoly.FromBuffer]
+// on a contiguous buffer).
+// The caller must not use p after the call.
+func (pool *RNSPolyPool) Put(p *RNSPoly) {
+
+	if p == nil || len(*p) == 0 {
+		return
+	}
+
+	buf := []uint64((*p)[0][:p.BufferSize(p.N(), p.Level())])
+
+	pool.pool.Put(&buf)
+
+	*p = nil
+}
@@ -0,0 +1,42 @@
+package ring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRNSPolyPool(t *testing.T) {
+
+	pool := NewRNSPolyPool()
+
+	p := pool.Get(16, 3)
+	require.Equal(t, 16, p.N())
+	require.Equal(t, 3, p.Level())
+
+	// Writes on the polynomial before returning it to the pool
+	for i := range *p {
+		for j := range (*p)[i] {
+			(*p)[i][j] = 0xFFFFFFFFFFFFFFFF
+		}
+	}
+
+	pool.Put(p)
+
+	// Polynomials drawn from the pool are zeroed
+	q := pool.Get(16, 3)
+	require.Equal(t, NewRNSPoly(16, 3), *q)
+
+	// Backing arrays are recycled across dimensions and grown as needed
+	r := pool.Get(32, 7)
+	require.Equal(t, 32, r.N())
+	require.Equal(t, 7, r.Level())
+	require.Equal(t, NewRNSPoly(32, 7), *r)
+
+	pool.Put(q)
+	pool.Put(r)
+
+	// Put of a nil or zero-value polynomial is a no-op
+	pool.Put(nil)
+	pool.Put(&RNSPoly{})
+}
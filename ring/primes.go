@@ -12,6 +12,58 @@ func IsPrime(x uint64) bool {
 	return bignum.NewInt(x).ProbablyPrime(0)
 }
 
+// IsNTTFriendly returns whether modulus supports the negacyclic NTT of size n, i.e.
+// whether modulus is a prime congruent to 1 modulo 2n, along with a diagnostic message
+// stating the first unmet requirement, or confirming the compatibility.
+func IsNTTFriendly(modulus uint64, n int) (bool, string) {
+
+	if n < 1 || n&(n-1) != 0 {
+		return false, fmt.Sprintf("invalid ring degree: n=%d is not a power of two", n)
+	}
+
+	NthRoot := uint64(n) << 1
+
+	if r := modulus % NthRoot; r != 1 {
+		return false, fmt.Sprintf("modulus %d = %d mod 2n=%d: the negacyclic NTT of size n=%d requires a 2n-th primitive root of unity, i.e. modulus = 1 mod %d", modulus, r, NthRoot, n, NthRoot)
+	}
+
+	if !IsPrime(modulus) {
+		return false, fmt.Sprintf("modulus %d is not prime", modulus)
+	}
+
+	return true, fmt.Sprintf("modulus %d is prime and = 1 mod 2n=%d: it supports the negacyclic NTT of size n=%d", modulus, NthRoot, n)
+}
+
+// GenerateNTTFriendlyPrimes returns count distinct primes of bitSize bits supporting the
+// negacyclic NTT of size n, i.e. primes congruent to 1 modulo 2n, alternating above and
+// below 2^{bitSize} to minimize the distance to the power of two (see
+// [NTTFriendlyPrimesGenerator] for finer-grained control over the generation). It
+// returns an error if the inputs are invalid or if there are not enough such primes of
+// the requested bit-size.
+func GenerateNTTFriendlyPrimes(bitSize, n, count int) ([]uint64, error) {
+
+	if n < 1 || n&(n-1) != 0 {
+		return nil, fmt.Errorf("cannot GenerateNTTFriendlyPrimes: invalid ring degree: n=%d is not a power of two", n)
+	}
+
+	if bitSize < 2 || bitSize > 61 {
+		return nil, fmt.Errorf("cannot GenerateNTTFriendlyPrimes: invalid bitSize=%d: must be in [2, 61]", bitSize)
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("cannot GenerateNTTFriendlyPrimes: invalid count=%d: must be positive", count)
+	}
+
+	g := NewNTTFriendlyPrimesGenerator(uint64(bitSize), uint64(n)<<1)
+
+	primes, err := g.NextAlternatingPrimes(count)
+	if err != nil {
+		return nil, fmt.Errorf("cannot GenerateNTTFriendlyPrimes: %w", err)
+	}
+
+	return primes, nil
+}
+
 // NTTFriendlyPrimesGenerator is a struct used to generate NTT friendly primes.
 type NTTFriendlyPrimesGenerator struct {
 	Size                           float64
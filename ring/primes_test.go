@@ -0,0 +1,58 @@
+package ring
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNTTFriendly(t *testing.T) {
+
+	// 65537 is prime and 65537 = 1 mod 2048
+	ok, msg := IsNTTFriendly(65537, 1024)
+	require.True(t, ok, msg)
+
+	// Non power-of-two ring degree
+	ok, msg = IsNTTFriendly(65537, 1000)
+	require.False(t, ok)
+	require.Contains(t, msg, "not a power of two")
+
+	// Wrong residue: 65539 = 3 mod 2048
+	ok, msg = IsNTTFriendly(65539, 1024)
+	require.False(t, ok)
+	require.Contains(t, msg, "mod 2n")
+
+	// Correct residue but composite: 2049 = 1 mod 2048 = 3 * 683
+	ok, msg = IsNTTFriendly(2049, 1024)
+	require.False(t, ok)
+	require.Contains(t, msg, "not prime")
+}
+
+func TestGenerateNTTFriendlyPrimes(t *testing.T) {
+
+	n := 1024
+	bitSize := 30
+
+	primes, err := GenerateNTTFriendlyPrimes(bitSize, n, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(primes))
+
+	seen := map[uint64]bool{}
+	for _, p := range primes {
+		ok, msg := IsNTTFriendly(p, n)
+		require.True(t, ok, msg)
+		require.InDelta(t, float64(bitSize), math.Log2(float64(p)), 0.5)
+		require.False(t, seen[p])
+		seen[p] = true
+	}
+
+	_, err = GenerateNTTFriendlyPrimes(bitSize, 1000, 5)
+	require.Error(t, err)
+
+	_, err = GenerateNTTFriendlyPrimes(62, n, 5)
+	require.Error(t, err)
+
+	_, err = GenerateNTTFriendlyPrimes(bitSize, n, 0)
+	require.Error(t, err)
+}
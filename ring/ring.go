@@ -217,6 +217,55 @@ func (r *Ring) GenNTTTable() (err error) {
 	return
 }
 
+// PrecomputeNTTTables precomputes and returns the [NTTTable] for the standard NTT of degree n
+// (i.e. with 2n-th primitive root of unity) and the given modulus. The returned table can be
+// shared across transient rings instantiated with [NewRingFromNTTTable] or
+// [NewRNSRingFromNTTTables], amortizing the setup cost when many rings over the same moduli
+// are created.
+func PrecomputeNTTTables(modulus uint64, n int) (*NTTTable, error) {
+
+	r, err := NewRing(n, modulus, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if modulus&uint64(2*n-1) != 1 {
+		return nil, fmt.Errorf("modulus %d is not NTT-friendly: must be congruent to 1 mod 2N=%d", modulus, 2*n)
+	}
+
+	if err = r.GenNTTTable(); err != nil {
+		return nil, err
+	}
+
+	return r.NTTTable, nil
+}
+
+// NewRingFromNTTTable creates a new [Ring] with the standard NTT, reusing the provided
+// precomputed [NTTTable] instead of regenerating it. The table must have been generated
+// with [PrecomputeNTTTables] for the same modulus and ring degree.
+func NewRingFromNTTTable(N int, Modulus uint64, table *NTTTable) (r *Ring, err error) {
+
+	if table == nil {
+		return nil, fmt.Errorf("invalid table: cannot be nil")
+	}
+
+	if table.NthRoot != uint64(2*N) || len(table.RootsForward) != N {
+		return nil, fmt.Errorf("invalid table: was generated for NthRoot=%d but ring degree N=%d requires NthRoot=%d", table.NthRoot, N, 2*N)
+	}
+
+	if r, err = NewRing(N, Modulus, 1); err != nil {
+		return nil, err
+	}
+
+	r.NTTTable = table
+
+	// The NumberTheoreticTransformer captures the NTTTable at construction,
+	// hence it must be re-instantiated after the table swap.
+	r.NumberTheoreticTransformer = NewNumberTheoreticTransformerStandard(r, N)
+
+	return
+}
+
 // PrimitiveRoot computes the smallest primitive root of the given prime q
 // The unique factors of q-1 can be given to speed up the search for the root.
 func PrimitiveRoot(q uint64, factors []uint64) (uint64, []uint64, error) {
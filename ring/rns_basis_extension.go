@@ -69,6 +69,72 @@ func ExtendBasisSmallNorm(Q uint64, P Poly, pQ, pP RNSPoly) {
 	}
 }
 
+// FastBasisExtender stores the precomputations required for the CRT-based fast
+// basis conversions between the moduli chains of two [RNSRing], enabling repeated
+// conversions without regenerating the constants at each call, which [RNSRing.ModUp]
+// and [RNSRing.ModDown] do.
+//
+// The cached constants are, for each direction of the conversion, (Q/qi)^-1 mod qi
+// and Q/qi mod pj (both in Montgomery form) along with the correction terms v*Q mod pj
+// for the approximate CRT reconstruction, as well as P^-1 mod qi for the reduction.
+type FastBasisExtender struct {
+	rQ, rP RNSRing
+
+	modUpQtoP ModUpConstants
+	modUpPtoQ ModUpConstants
+	modDownQP []uint64
+
+	buffQ RNSPoly
+	buffP RNSPoly
+}
+
+// NewFastBasisExtender instantiates a new FastBasisExtender between the moduli
+// chains of rQ and rP, precomputing and caching the conversion constants.
+func NewFastBasisExtender(rQ, rP RNSRing) (be *FastBasisExtender) {
+	be = &FastBasisExtender{rQ: rQ, rP: rP}
+	be.Precompute()
+	return
+}
+
+// Precompute (re)generates and caches the conversion constants for the current
+// moduli chains of the two rings. It is called by NewFastBasisExtender and only
+// needs to be called again if the underlying rings are modified.
+func (be *FastBasisExtender) Precompute() {
+	be.modUpQtoP = be.rQ.ModUpConstants(be.rP)
+	be.modUpPtoQ = be.rP.ModUpConstants(be.rQ)
+	be.modDownQP = be.rQ.ModDownConstants(be.rP)
+	be.buffQ = be.rQ.NewRNSPoly()
+	be.buffP = be.rP.NewRNSPoly()
+}
+
+// Extend takes pQ in the basis Q and stores on pP its centered representation in
+// the basis P, using the cached constants. It is equivalent to [RNSRing.ModUp].
+// Caution: output values are lazily reduced, in [0, 2pj-1].
+func (be *FastBasisExtender) Extend(pQ, pP RNSPoly) {
+	rQ, rP := be.rQ, be.rP
+	QHalf := bignum.NewInt(rQ.Modulus())
+	QHalf.Rsh(QHalf, 1)
+	rQ.AddScalarBigint(pQ, QHalf, be.buffQ)
+	ModUpExact(rQ, rP, be.buffQ, pP, be.modUpQtoP)
+	rP.SubScalarBigint(pP, QHalf, pP)
+}
+
+// Reduce takes pQP = [pQ, pP] in the basis QP and stores on pOut its value divided
+// by P, using the cached constants. Division is centered and rounded. It is
+// equivalent to [RNSRing.ModDown].
+// Caution: output values are lazily reduced, in [0, 2qi-1].
+func (be *FastBasisExtender) Reduce(pQ, pP, pOut RNSPoly) {
+	rQ, rP := be.rQ, be.rP
+	PHalf := bignum.NewInt(rP.Modulus())
+	PHalf.Rsh(PHalf, 1)
+	rP.AddScalarBigint(pP, PHalf, be.buffP)
+	ModUpExact(rP, rQ, be.buffP, be.buffQ, be.modUpPtoQ)
+	rQ.SubScalarBigint(be.buffQ, PHalf, be.buffQ)
+	for i, s := range rQ {
+		s.SubThenMulScalarMontgomeryTwoModulus(be.buffQ.At(i), pQ.At(i), s.Modulus-be.modDownQP[i], pOut.At(i))
+	}
+}
+
 // ModUpConstants stores the necessary parameters for RNS basis extension.
 type ModUpConstants struct {
 	// Parameters for basis extension from Q to P
@@ -298,13 +364,14 @@ func (decomposer *Decomposer) DecomposeAndSplit(LevelQ, LevelP, BaseRNSDecomposi
 	rQ := decomposer.rQ.AtLevel(LevelQ)
 
 	var rP RNSRing
-	if decomposer.rP != nil {
+	if decomposer.rP != nil && LevelP > -1 {
 		rP = decomposer.rP.AtLevel(LevelP)
 	}
 
 	N := rQ.N()
 
-	lvlQStart := BaseRNSDecompositionVectorSize * (LevelP + 1)
+	// If LevelP = -1 (gadget without auxiliary prime), each digit spans a single prime q_i.
+	lvlQStart := BaseRNSDecompositionVectorSize * max(1, LevelP+1)
 
 	var decompLvl int
 	if LevelQ > (LevelP+1)*(BaseRNSDecompositionVectorSize+1)-1 {
@@ -14,6 +14,14 @@ func (r RNSRing) NTTLazy(p1, p2 RNSPoly) {
 	}
 }
 
+// NTTInPlace evaluates p = NTT(p) without internal buffers or heap allocation.
+// See [Ring.NTTInPlace].
+func (r RNSRing) NTTInPlace(p RNSPoly) {
+	for i, s := range r {
+		s.NTTInPlace(p.At(i))
+	}
+}
+
 // INTT evaluates p2 = INTT(p1).
 func (r RNSRing) INTT(p1, p2 RNSPoly) {
 	for i, s := range r {
@@ -27,3 +35,11 @@ func (r RNSRing) INTTLazy(p1, p2 RNSPoly) {
 		s.INTTLazy(p1.At(i), p2.At(i))
 	}
 }
+
+// INTTInPlace evaluates p = INTT(p) without internal buffers or heap allocation.
+// See [Ring.INTTInPlace].
+func (r RNSRing) INTTInPlace(p RNSPoly) {
+	for i, s := range r {
+		s.INTTInPlace(p.At(i))
+	}
+}
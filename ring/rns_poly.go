@@ -196,6 +196,10 @@ func (p RNSPoly) BinarySize() (size int) {
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
 // interface, and will write exactly object.BinarySize() bytes on w.
 //
+// The lengths and the coefficients are encoded as 64-bit little-endian words,
+// regardless of the platform's native byte order, making the binary format
+// portable across architectures (see lattigo/utils/buffer).
+//
 // Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
 // it will be wrapped into a bufio.Writer. Since this requires allocations, it
 // is preferable to pass a buffer.Writer directly:
@@ -108,6 +108,35 @@ func NewRNSRingFromRings(rings []*Ring) (r RNSRing, err error) {
 	return RNSRing(rings), nil
 }
 
+// NewRNSRingFromNTTTables creates a new [RNSRing] with degree N and coefficient moduli ModuliChain
+// with the standard NTT, reusing the provided precomputed [NTTTable] (one per modulus, in the same
+// order) instead of regenerating them. The tables must have been generated with
+// [PrecomputeNTTTables] for the same degree and moduli.
+func NewRNSRingFromNTTTables(N int, ModuliChain []uint64, tables []*NTTTable) (r RNSRing, err error) {
+
+	if len(ModuliChain) == 0 {
+		return nil, fmt.Errorf("invalid ModuliChain (must be a non-empty []uint64)")
+	}
+
+	if !utils.AllDistinct(ModuliChain) {
+		return nil, fmt.Errorf("invalid ModuliChain (moduli are not distinct)")
+	}
+
+	if len(tables) != len(ModuliChain) {
+		return nil, fmt.Errorf("invalid tables: length (%d) does not match ModuliChain length (%d)", len(tables), len(ModuliChain))
+	}
+
+	r = make([]*Ring, len(ModuliChain))
+
+	for i := range r {
+		if r[i], err = NewRingFromNTTTable(N, ModuliChain[i], tables[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
 // N returns the ring degree.
 func (r RNSRing) N() int {
 	return r[0].N
@@ -305,6 +334,31 @@ func (r RNSRing) PolyToBigintCentered(p1 RNSPoly, gap int, values []big.Int) {
 	PolyToBigintCentered(r, nil, p1, nil, gap, values)
 }
 
+// CRTReconstruct reconstructs the integer coefficients of p across all moduli
+// at the ring's current level and returns them in an array of *Int.
+// If centered is true, the coefficients are mapped to the interval (-Q/2, Q/2],
+// else they are returned in [0, Q).
+// This method is intended for debugging and introspection, e.g. to inspect the
+// actual integer coefficients of a plaintext or ciphertext polynomial when
+// diagnosing scale or overflow issues.
+func (r RNSRing) CRTReconstruct(p RNSPoly, centered bool) []*big.Int {
+
+	values := make([]big.Int, r.N())
+
+	if centered {
+		r.PolyToBigintCentered(p, 1, values)
+	} else {
+		r.PolyToBigint(p, 1, values)
+	}
+
+	coeffs := make([]*big.Int, len(values))
+	for i := range values {
+		coeffs[i] = &values[i]
+	}
+
+	return coeffs
+}
+
 // Equal checks if p1 = p2 in the given Ring.
 func (r RNSRing) Equal(p1, p2 RNSPoly) bool {
 
@@ -329,6 +383,43 @@ func (r RNSRing) Stats(poly RNSPoly) [2]float64 {
 	return bignum.Stats(values, 128)
 }
 
+// L2Norm returns the Euclidean norm of the coefficients of the polynomial,
+// reconstructed via CRT and centered around zero.
+// The polynomial must be in the coefficient (non-NTT) domain.
+func (r RNSRing) L2Norm(poly RNSPoly) (norm *big.Float) {
+
+	values := make([]big.Int, r.N())
+	r.PolyToBigintCentered(poly, 1, values)
+
+	acc := new(big.Int)
+	tmp := new(big.Int)
+	for i := range values {
+		acc.Add(acc, tmp.Mul(&values[i], &values[i]))
+	}
+
+	return new(big.Float).SetPrec(128).Sqrt(new(big.Float).SetPrec(128).SetInt(acc))
+}
+
+// LInfNorm returns the infinity norm of the coefficients of the polynomial,
+// i.e. the largest absolute value of the coefficients reconstructed via CRT
+// and centered around zero.
+// The polynomial must be in the coefficient (non-NTT) domain.
+func (r RNSRing) LInfNorm(poly RNSPoly) (norm *big.Int) {
+
+	values := make([]big.Int, r.N())
+	r.PolyToBigintCentered(poly, 1, values)
+
+	norm = new(big.Int)
+	tmp := new(big.Int)
+	for i := range values {
+		if tmp.Abs(&values[i]).Cmp(norm) == 1 {
+			norm.Set(tmp)
+		}
+	}
+
+	return
+}
+
 // String returns the string representation of the ring Type
 func (rt Type) String() string {
 	switch rt {
@@ -273,6 +273,20 @@ func benchExtendBasis(tc *testParams, b *testing.B) {
 			tc.ringQ.ModDownNTT(tc.ringP, p0, p1, buffQ, buffP, p0)
 		}
 	})
+
+	be := NewFastBasisExtender(tc.ringQ, tc.ringP)
+
+	b.Run(fmt.Sprintf("ExtendBasis/FastBasisExtender/Extend/N=%d/limbsQ=%d/limbsP=%d", tc.ringQ.N(), tc.ringQ.ModuliChainLength(), tc.ringP.ModuliChainLength()), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			be.Extend(p0, p1)
+		}
+	})
+
+	b.Run(fmt.Sprintf("ExtendBasis/FastBasisExtender/Reduce/N=%d/limbsQ=%d/limbsP=%d", tc.ringQ.N(), tc.ringQ.ModuliChainLength(), tc.ringP.ModuliChainLength()), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			be.Reduce(p0, p1, p0)
+		}
+	})
 }
 
 func benchDivByLastModulus(tc *testParams, b *testing.B) {
@@ -211,6 +211,23 @@ func (r RNSRing) MulScalarThenAdd(p1 RNSPoly, scalar uint64, p2 RNSPoly) {
 	}
 }
 
+// MulScalarThenAddScalar evaluates p2 = p1 * scalar1 + scalar0 coefficient-wise in the ring.
+func (r RNSRing) MulScalarThenAddScalar(p1 RNSPoly, scalar0, scalar1 uint64, p2 RNSPoly) {
+	for i, s := range r {
+		s.MulScalarMontgomeryThenAddScalar(p1.At(i), BRedAdd(scalar0, s.Modulus, s.BRedConstant), MForm(scalar1, s.Modulus, s.BRedConstant), p2.At(i))
+	}
+}
+
+// MulScalarThenAddScalarBigint evaluates p2 = p1 * scalar1 + scalar0 coefficient-wise in the ring.
+func (r RNSRing) MulScalarThenAddScalarBigint(p1 RNSPoly, scalar0, scalar1 *big.Int, p2 RNSPoly) {
+	tmp := new(big.Int)
+	for i, s := range r {
+		s0 := tmp.Mod(scalar0, bignum.NewInt(s.Modulus)).Uint64()
+		s1 := MForm(tmp.Mod(scalar1, bignum.NewInt(s.Modulus)).Uint64(), s.Modulus, s.BRedConstant)
+		s.MulScalarMontgomeryThenAddScalar(p1.At(i), s0, s1, p2.At(i))
+	}
+}
+
 // MulRNSScalarMontgomery evaluates p2 = p1 * scalar coefficient-wise in the ring, with a scalar value expressed in the CRT decomposition at a given level.
 // It assumes the scalar decomposition to be in Montgomery form.
 func (r RNSRing) MulRNSScalarMontgomery(p1 RNSPoly, scalar RNSScalar, p2 RNSPoly) {
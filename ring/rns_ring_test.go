@@ -2,9 +2,11 @@ package ring
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"testing"
 
 	"github.com/Pro7ech/lattigo/utils/buffer"
@@ -98,10 +100,202 @@ func TestRNSRing(t *testing.T) {
 		testMulScalarBigint(tc, t)
 		testExtendBasis(tc, t)
 		testMultByMonomial(tc, t)
+		testNorms(tc, t)
+		testVecOpsParallel(tc, t)
+		testPrecomputedNTTTables(tc, t)
+		testCRTReconstruct(tc, t)
+		testRNSScalar(tc, t)
 
 	}
 }
 
+func testRNSScalar(tc *testParams, t *testing.T) {
+
+	t.Run(testString("RNSScalar", tc.ringQ), func(t *testing.T) {
+
+		rQ := tc.ringQ
+		Q := rQ.Modulus()
+
+		source := sampling.NewSource([32]byte{})
+
+		a := bignum.RandInt(source, Q)
+		b := bignum.RandInt(source, Q)
+
+		sa := rQ.NewRNSScalarFromBigint(a)
+		sb := rQ.NewRNSScalarFromBigint(b)
+
+		have := new(big.Int)
+		want := new(big.Int)
+
+		// FromBigint <-> ToBigint round-trip
+		rQ.RNSScalarToBigint(sa, have)
+		require.Equal(t, 0, have.Cmp(want.Mod(a, Q)))
+
+		sout := rQ.NewRNSScalar()
+
+		// Add
+		rQ.AddRNSScalar(sa, sb, sout)
+		rQ.RNSScalarToBigint(sout, have)
+		want.Add(a, b).Mod(want, Q)
+		require.Equal(t, 0, have.Cmp(want))
+
+		// Sub
+		rQ.SubRNSScalar(sa, sb, sout)
+		rQ.RNSScalarToBigint(sout, have)
+		want.Sub(a, b).Mod(want, Q)
+		require.Equal(t, 0, have.Cmp(want))
+
+		// Mul (in the Montgomery domain)
+		saMont := rQ.NewRNSScalar()
+		rQ.MFormRNSScalar(sa, saMont)
+		rQ.MulRNSScalar(saMont, sb, sout)
+		rQ.RNSScalarToBigint(sout, have)
+		want.Mul(a, b).Mod(want, Q)
+		require.Equal(t, 0, have.Cmp(want))
+
+		// Inv
+		rQ.InverseRNSScalar(sa, sout)
+		rQ.RNSScalarToBigint(sout, have)
+		require.NotNil(t, want.ModInverse(a, Q))
+		require.Equal(t, 0, have.Cmp(want))
+	})
+}
+
+func testPrecomputedNTTTables(tc *testParams, t *testing.T) {
+
+	t.Run(testString("PrecomputedNTTTables", tc.ringQ), func(t *testing.T) {
+
+		N := tc.ringQ.N()
+		moduli := tc.ringQ.ModuliChain()
+
+		var err error
+
+		tables := make([]*NTTTable, len(moduli))
+		for i := range tables {
+			tables[i], err = PrecomputeNTTTables(moduli[i], N)
+			require.NoError(t, err)
+		}
+
+		r, err := NewRNSRingFromNTTTables(N, moduli, tables)
+		require.NoError(t, err)
+
+		p := tc.uniformSamplerQ.ReadNew(N)
+
+		want := tc.ringQ.NewRNSPoly()
+		have := tc.ringQ.NewRNSPoly()
+
+		tc.ringQ.NTT(p, want)
+		r.NTT(p, have)
+
+		require.Equal(t, want, have)
+
+		tc.ringQ.INTT(want, want)
+		r.INTT(have, have)
+
+		require.Equal(t, want, have)
+
+		// Non NTT-friendly modulus (7 != 1 mod 2N)
+		_, err = PrecomputeNTTTables(7, N)
+		require.Error(t, err)
+
+		// Table generated for a different ring degree
+		_, err = NewRingFromNTTTable(N<<1, moduli[0], tables[0])
+		require.Error(t, err)
+	})
+}
+
+func testCRTReconstruct(tc *testParams, t *testing.T) {
+
+	t.Run(testString("CRTReconstruct", tc.ringQ), func(t *testing.T) {
+
+		r := tc.ringQ
+
+		p := tc.uniformSamplerQ.ReadNew(r.N())
+
+		want := make([]big.Int, r.N())
+		r.PolyToBigint(p, 1, want)
+
+		have := r.CRTReconstruct(p, false)
+		require.Equal(t, r.N(), len(have))
+		for i := range have {
+			require.Equal(t, 0, want[i].Cmp(have[i]))
+		}
+
+		wantCentered := make([]big.Int, r.N())
+		r.PolyToBigintCentered(p, 1, wantCentered)
+
+		haveCentered := r.CRTReconstruct(p, true)
+
+		QHalf := new(big.Int).Rsh(r.Modulus(), 1)
+		for i := range haveCentered {
+			require.Equal(t, 0, wantCentered[i].Cmp(haveCentered[i]))
+			require.True(t, haveCentered[i].CmpAbs(QHalf) <= 0)
+		}
+	})
+}
+
+func testVecOpsParallel(tc *testParams, t *testing.T) {
+
+	t.Run(testString("VecOpsParallel", tc.ringQ), func(t *testing.T) {
+
+		s := tc.ringQ[0]
+
+		// Large enough to take the parallel path
+		N := 2 * MinVecLenParallel
+
+		r := sampling.NewSource([32]byte{})
+
+		p1 := make([]uint64, N)
+		p2 := make([]uint64, N)
+		for i := range p1 {
+			p1[i] = r.Uint64() % s.Modulus
+			p2[i] = r.Uint64() % s.Modulus
+		}
+
+		want := make([]uint64, N)
+		have := make([]uint64, N)
+
+		for _, threads := range []int{1, 3, 4} {
+
+			AddVec(p1, p2, want, s.Modulus)
+			AddVecParallel(p1, p2, have, s.Modulus, threads)
+			require.Equal(t, want, have)
+
+			MulMontgomeryReduceVec(p1, p2, want, s.Modulus, s.MRedConstant)
+			MulMontgomeryReduceVecParallel(p1, p2, have, s.Modulus, s.MRedConstant, threads)
+			require.Equal(t, want, have)
+		}
+
+		// Below the threshold the serial path is taken
+		n := MinVecLenParallel >> 1
+		AddVec(p1[:n], p2[:n], want[:n], s.Modulus)
+		AddVecParallel(p1[:n], p2[:n], have[:n], s.Modulus, 4)
+		require.Equal(t, want[:n], have[:n])
+	})
+}
+
+func testNorms(tc *testParams, t *testing.T) {
+
+	t.Run(testString("Norms", tc.ringQ), func(t *testing.T) {
+
+		ringQ := tc.ringQ
+
+		p := ringQ.NewRNSPoly()
+
+		// p = 5 - 3*X, i.e. centered coefficients {5, -3}
+		for i, s := range ringQ {
+			p.At(i)[0] = 5
+			p.At(i)[1] = s.Modulus - 3
+		}
+
+		require.Equal(t, 0, big.NewInt(5).Cmp(ringQ.LInfNorm(p)))
+
+		// sqrt(5^2 + 3^2)
+		want := new(big.Float).SetPrec(128).Sqrt(new(big.Float).SetInt64(34))
+		require.Equal(t, 0, want.Cmp(ringQ.L2Norm(p)))
+	})
+}
+
 func testNTTConjugateInvariant(tc *testParams, t *testing.T) {
 
 	t.Run(testString("NTTConjugateInvariant", tc.ringQ), func(t *testing.T) {
@@ -363,6 +557,50 @@ func testMarshalBinary(tc *testParams, t *testing.T) {
 		buffer.RequireSerializerCorrect(t, &poly)
 	})
 
+	t.Run(testString("MarshalBinary/RNSPoly/ByteOrder", tc.ringQ), func(t *testing.T) {
+
+		poly := tc.uniformSamplerQ.ReadNew(tc.ringQ.N())
+
+		data, err := poly.MarshalBinary()
+		require.NoError(t, err)
+
+		// The binary format is guaranteed to be little-endian regardless of the
+		// platform's native byte order, thus the expected byte stream can be
+		// reconstructed explicitly: [#polys][[#coeffs][coeffs...]...] with
+		// 64-bit little-endian words.
+		expected := make([]byte, 0, poly.BinarySize())
+		expected = binary.LittleEndian.AppendUint64(expected, uint64(len(poly)))
+		for i := range poly {
+			expected = binary.LittleEndian.AppendUint64(expected, uint64(len(poly[i])))
+			for _, c := range poly[i] {
+				expected = binary.LittleEndian.AppendUint64(expected, c)
+			}
+		}
+
+		require.True(t, bytes.Equal(expected, data))
+
+		// A stream whose coefficients were written in big-endian is decoded as the
+		// byte-reversed coefficients: the interpretation of the decoder is fixed
+		// and does not depend on the byte order of the writer's platform.
+		swapped := make([]byte, 0, poly.BinarySize())
+		swapped = binary.LittleEndian.AppendUint64(swapped, uint64(len(poly)))
+		for i := range poly {
+			swapped = binary.LittleEndian.AppendUint64(swapped, uint64(len(poly[i])))
+			for _, c := range poly[i] {
+				swapped = binary.BigEndian.AppendUint64(swapped, c)
+			}
+		}
+
+		var polyTest RNSPoly
+		require.NoError(t, polyTest.UnmarshalBinary(swapped))
+
+		for i := range poly {
+			for j, c := range poly[i] {
+				require.Equal(t, bits.ReverseBytes64(c), polyTest[i][j])
+			}
+		}
+	})
+
 	t.Run(testString("structs/PolyVector", tc.ringQ), func(t *testing.T) {
 
 		polys := make([]RNSPoly, 4)
@@ -798,6 +1036,87 @@ func testExtendBasis(tc *testParams, t *testing.T) {
 			}
 		}
 	})
+
+	t.Run(testString("FastBasisExtender/Extend", tc.ringQ), func(t *testing.T) {
+
+		source := sampling.NewSource(sampling.NewSeed())
+
+		rQ := tc.ringQ
+		rP := tc.ringP
+
+		be := NewFastBasisExtender(rQ, rP)
+
+		Q := rQ.Modulus()
+
+		QHalf := new(big.Int).Set(Q)
+		QHalf.Rsh(QHalf, 1)
+
+		coeffs := make([]big.Int, N)
+		for i := 0; i < N; i++ {
+			coeffs[i] = *bignum.RandInt(source, Q)
+			coeffs[i].Sub(&coeffs[i], QHalf)
+		}
+
+		PolQHave := rQ.NewRNSPoly()
+		PolPTest := rP.NewRNSPoly()
+		PolPWant := rP.NewRNSPoly()
+
+		rQ.SetCoefficientsBigint(coeffs, PolQHave)
+		rP.SetCoefficientsBigint(coeffs, PolPWant)
+
+		be.Extend(PolQHave, PolPTest)
+		rP.Reduce(PolPTest, PolPTest)
+
+		for i := 0; i < PolPWant.Level()+1; i++ {
+			for j := 0; j < N; j++ {
+				require.Equal(t, PolPWant.At(i)[j], PolPTest.At(i)[j])
+			}
+		}
+	})
+
+	t.Run(testString("FastBasisExtender/Reduce", tc.ringQ), func(t *testing.T) {
+
+		source := sampling.NewSource(sampling.NewSeed())
+
+		rQ := tc.ringQ
+		rP := tc.ringP
+
+		be := NewFastBasisExtender(rQ, rP)
+
+		Q := rQ.Modulus()
+		P := rP.Modulus()
+
+		QP := new(big.Int).Mul(Q, P)
+
+		coeffs := make([]big.Int, N)
+		for i := 0; i < N; i++ {
+			coeffs[i] = *bignum.RandInt(source, QP)
+			coeffs[i].Quo(&coeffs[i], bignum.NewInt(10))
+		}
+
+		coeffsWant := make([]big.Int, N)
+		for i := range coeffs {
+			coeffsWant[i].Set(&coeffs[i])
+			bignum.DivRound(&coeffsWant[i], P, &coeffsWant[i])
+		}
+
+		PolQHave := rQ.NewRNSPoly()
+		PolPHave := rP.NewRNSPoly()
+		PolQWant := rQ.NewRNSPoly()
+
+		rQ.SetCoefficientsBigint(coeffs, PolQHave)
+		rP.SetCoefficientsBigint(coeffs, PolPHave)
+		rQ.SetCoefficientsBigint(coeffsWant, PolQWant)
+
+		be.Reduce(PolQHave, PolPHave, PolQHave)
+		rQ.Reduce(PolQHave, PolQHave)
+
+		for i := 0; i < PolQHave.Level()+1; i++ {
+			for j := 0; j < N; j++ {
+				require.Equal(t, PolQHave.At(i)[j], PolQWant.At(i)[j])
+			}
+		}
+	})
 }
 
 func testMultByMonomial(tc *testParams, t *testing.T) {
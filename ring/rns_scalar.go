@@ -32,6 +32,30 @@ func (r RNSRing) NewRNSScalarFromBigint(v *big.Int) (rns RNSScalar) {
 	return rns
 }
 
+// RNSScalarToBigint reconstructs the value of an RNS scalar across all moduli
+// at the ring's current level through the CRT and writes the result, reduced
+// in the interval [0, Q), on v.
+func (r RNSRing) RNSScalarToBigint(s RNSScalar, v *big.Int) {
+
+	QiB := new(big.Int)
+	tmp := new(big.Int)
+	crt := new(big.Int)
+	modulusBigint := r.Modulus()
+
+	v.SetUint64(0)
+
+	for i, table := range r {
+		QiB.SetUint64(table.Modulus)
+		crt.Quo(modulusBigint, QiB)
+		tmp.ModInverse(crt, QiB)
+		tmp.Mod(tmp, QiB)
+		crt.Mul(crt, tmp)
+		v.Add(v, crt.Mul(crt, tmp.SetUint64(s[i])))
+	}
+
+	v.Mod(v, modulusBigint)
+}
+
 // MFormRNSScalar switches an RNS scalar to the Montgomery domain.
 // s2 = s1<<64 mod Q
 func (r RNSRing) MFormRNSScalar(s1, s2 RNSScalar) {
@@ -47,6 +71,13 @@ func (r RNSRing) NegRNSScalar(s1, s2 RNSScalar) {
 	}
 }
 
+// AddRNSScalar adds s2 to s1 and stores the result in sout.
+func (r RNSRing) AddRNSScalar(s1, s2, sout RNSScalar) {
+	for i, s := range r {
+		sout[i] = CRed(s1[i]+s2[i], s.Modulus)
+	}
+}
+
 // SubRNSScalar subtracts s2 to s1 and stores the result in sout.
 func (r RNSRing) SubRNSScalar(s1, s2, sout RNSScalar) {
 	for i, s := range r {
@@ -66,6 +97,15 @@ func (r RNSRing) MulRNSScalar(s1, s2, sout RNSScalar) {
 	}
 }
 
+// InverseRNSScalar computes sout = s1^-1 mod Q for a scalar expressed in a CRT
+// decomposition in the standard domain.
+// The scalar must be invertible, i.e. non-zero modulo each prime of the moduli chain.
+func (r RNSRing) InverseRNSScalar(s1, sout RNSScalar) {
+	for i, s := range r {
+		sout[i] = ModExp(s1[i], s.Modulus-2, s.Modulus)
+	}
+}
+
 // Inverse computes the modular inverse of a scalar a expressed in a CRT decomposition.
 // The inversion is done in-place and assumes that a is in Montgomery form.
 func (r RNSRing) Inverse(a RNSScalar) {
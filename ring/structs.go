@@ -487,6 +487,29 @@ func (v Vector) Clone() (clone *Vector) {
 	return
 }
 
+// CloneAtLevelQ returns a new Vector which is a deep copy of the receiver
+// with the modulus Q truncated to LevelQ. Unlike Clone followed by ResizeQ,
+// only the storage of the target level is allocated.
+// LevelQ must be a positive integer 0 <= LevelQ <= v.LevelQ().
+func (v Vector) CloneAtLevelQ(LevelQ int) (clone *Vector) {
+
+	if LevelQ < 0 || LevelQ > v.LevelQ() {
+		panic(fmt.Errorf("invalid LevelQ: must be a positive integer 0 <= LevelQ <= v.LevelQ()=%d", v.LevelQ()))
+	}
+
+	clone = NewVector(v.N(), LevelQ, v.LevelP(), v.Size())
+
+	for i := range clone.Q {
+		clone.Q[i].CopyLvl(LevelQ, &v.Q[i])
+	}
+
+	for i := range clone.P {
+		clone.P[i].Copy(&v.P[i])
+	}
+
+	return
+}
+
 func (v Vector) Equal(other *Vector) (equal bool) {
 	return v.Q.Equal(other.Q) && v.P.Equal(other.P)
 }
@@ -590,6 +613,10 @@ func (v Vector) BinarySize() (size int) {
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
 // interface, and will write exactly object.BinarySize() bytes on w.
 //
+// The lengths and the coefficients are encoded as 64-bit little-endian words,
+// regardless of the platform's native byte order, making the binary format
+// portable across architectures (see lattigo/utils/buffer).
+//
 // Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
 // it will be wrapped into a bufio.Writer. Since this requires allocations, it
 // is preferable to pass a buffer.Writer directly:
@@ -0,0 +1,72 @@
+package ring
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MinVecLenParallel is the minimum vector size below which the parallel
+// vector operations fall back to their serial variant, to avoid the
+// goroutine overhead dominating the computation.
+const MinVecLenParallel = 1 << 14
+
+// AddVecParallel evaluates p3 = p1 + p2 - modulus if p3 >= modulus, splitting
+// the range [0, N) across at most threads goroutines.
+// Falls back to [AddVec] if threads <= 1 or N < [MinVecLenParallel].
+// p1, p2, p3 must be of the same size.
+func AddVecParallel(p1, p2, p3 []uint64, modulus uint64, threads int) {
+
+	N := len(p1)
+
+	if len(p2) != N || len(p3) != N {
+		panic(fmt.Errorf("len(p1)=%d len(p2)=%d len(p3)=%d", N, len(p2), len(p3)))
+	}
+
+	vecParallel(N, threads, func(start, end int) {
+		AddVec(p1[start:end], p2[start:end], p3[start:end], modulus)
+	})
+}
+
+// MulMontgomeryReduceVecParallel evaluates p3 = p1 * p2, with Montgomery reduction,
+// splitting the range [0, N) across at most threads goroutines.
+// Falls back to [MulMontgomeryReduceVec] if threads <= 1 or N < [MinVecLenParallel].
+// p1, p2, p3 must be of the same size.
+func MulMontgomeryReduceVecParallel(p1, p2, p3 []uint64, modulus, mredconstant uint64, threads int) {
+
+	N := len(p1)
+
+	if len(p2) != N || len(p3) != N {
+		panic(fmt.Errorf("len(p1)=%d len(p2)=%d len(p3)=%d", N, len(p2), len(p3)))
+	}
+
+	vecParallel(N, threads, func(start, end int) {
+		MulMontgomeryReduceVec(p1[start:end], p2[start:end], p3[start:end], modulus, mredconstant)
+	})
+}
+
+// vecParallel splits the range [0, N) into at most threads contiguous chunks aligned
+// on multiples of 8 (to preserve the 8-wide unrolling of the serial functions) and
+// calls f on each chunk in its own goroutine. The output partitions are disjoint and
+// the modulus and constants are read-only, so no synchronization beyond the final
+// wait is needed. If threads <= 1 or N < [MinVecLenParallel], f is called on the full
+// range in the current goroutine.
+func vecParallel(N, threads int, f func(start, end int)) {
+
+	if threads <= 1 || N < MinVecLenParallel {
+		f(0, N)
+		return
+	}
+
+	chunk := (N/threads + 7) &^ 7
+
+	var wg sync.WaitGroup
+	for start := 0; start < N; start += chunk {
+		end := min(start+chunk, N)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f(start, end)
+		}()
+	}
+	wg.Wait()
+}
@@ -111,6 +111,14 @@ func (ct *Ciphertext) Clone() *Ciphertext {
 	return &Ciphertext{Vector: ct.Vector.Clone(), MetaData: ct.MetaData.Clone()}
 }
 
+// CloneAtLevel returns a deep copy of the receiver with the modulus Q
+// truncated to the given level. Unlike Clone followed by ResizeQ, only
+// the storage of the target level is allocated.
+// level must be a positive integer 0 <= level <= ct.Level().
+func (ct *Ciphertext) CloneAtLevel(level int) *Ciphertext {
+	return &Ciphertext{Vector: ct.Vector.CloneAtLevelQ(level), MetaData: ct.MetaData.Clone()}
+}
+
 // Copy copies the input element and its parameters on the receiver.
 func (ct *Ciphertext) Copy(other *Ciphertext) {
 	ct.Vector.Copy(other.Vector)
@@ -171,6 +179,95 @@ func (ct *Ciphertext) SwitchRingDegree(rQ, rP ring.RNSRing, buff []uint64, ctOut
 	}
 }
 
+// ToCoeffDomain switches the receiver to the coefficient domain, i.e. applies the inverse
+// NTT on its polynomials and updates its IsNTT flag accordingly.
+// Coefficient-domain ciphertexts compress better and spare the receiving party the cost of
+// guessing the domain, see [Ciphertext.WriteToCoeffDomain].
+// rP can be nil if the receiver has no P component.
+// The method is a no-op if the receiver is already in the coefficient domain.
+func (ct *Ciphertext) ToCoeffDomain(rQ, rP ring.RNSRing) {
+
+	if ct.MetaData == nil || !ct.IsNTT {
+		return
+	}
+
+	rQ = rQ.AtLevel(ct.LevelQ())
+	for i := range ct.Q {
+		rQ.INTT(ct.Q[i], ct.Q[i])
+	}
+
+	if LevelP := ct.LevelP(); rP != nil && LevelP > -1 {
+		rP = rP.AtLevel(LevelP)
+		for i := range ct.P {
+			rP.INTT(ct.P[i], ct.P[i])
+		}
+	}
+
+	ct.IsNTT = false
+}
+
+// ToNTTDomain switches the receiver to the NTT domain, i.e. applies the NTT on its
+// polynomials and updates its IsNTT flag accordingly.
+// rP can be nil if the receiver has no P component.
+// The method is a no-op if the receiver is already in the NTT domain.
+func (ct *Ciphertext) ToNTTDomain(rQ, rP ring.RNSRing) {
+
+	if ct.MetaData == nil || ct.IsNTT {
+		return
+	}
+
+	rQ = rQ.AtLevel(ct.LevelQ())
+	for i := range ct.Q {
+		rQ.NTT(ct.Q[i], ct.Q[i])
+	}
+
+	if LevelP := ct.LevelP(); rP != nil && LevelP > -1 {
+		rP = rP.AtLevel(LevelP)
+		for i := range ct.P {
+			rP.NTT(ct.P[i], ct.P[i])
+		}
+	}
+
+	ct.IsNTT = true
+}
+
+// WriteToCoeffDomain writes the object on an io.Writer following the same conventions as
+// [Ciphertext.WriteTo], except that the polynomials are always stored in the coefficient
+// domain, which both compresses better and is cheaper to transform back for the receiving
+// party. The IsNTT flag of the metadata records the logical domain of the receiver, which
+// is restored by [Ciphertext.ReadFromCoeffDomain].
+// The receiver is not modified; if it is in the NTT domain, the serialization works on a
+// temporary copy.
+func (ct *Ciphertext) WriteToCoeffDomain(w io.Writer, rQ, rP ring.RNSRing) (n int64, err error) {
+
+	if ct.MetaData == nil || !ct.IsNTT {
+		return ct.WriteTo(w)
+	}
+
+	tmp := ct.Clone()
+	tmp.ToCoeffDomain(rQ, rP)
+	tmp.IsNTT = true // Logical domain of the receiver, restored by ReadFromCoeffDomain
+
+	return tmp.WriteTo(w)
+}
+
+// ReadFromCoeffDomain reads on the object from an io.Reader a ciphertext written with
+// [Ciphertext.WriteToCoeffDomain], applying the NTT on its polynomials if the IsNTT flag
+// of the stored metadata indicates that the original ciphertext was in the NTT domain.
+func (ct *Ciphertext) ReadFromCoeffDomain(r io.Reader, rQ, rP ring.RNSRing) (n int64, err error) {
+
+	if n, err = ct.ReadFrom(r); err != nil {
+		return
+	}
+
+	if ct.MetaData != nil && ct.IsNTT {
+		ct.IsNTT = false
+		ct.ToNTTDomain(rQ, rP)
+	}
+
+	return
+}
+
 // Randomize populates the receiver with uniform random coefficients.
 func (ct *Ciphertext) Randomize(params ParameterProvider, source *sampling.Source) {
 	p := params.GetRLWEParameters()
@@ -179,7 +276,7 @@ func (ct *Ciphertext) Randomize(params ParameterProvider, source *sampling.Sourc
 
 // BinarySize returns the serialized size of the object in bytes.
 func (ct *Ciphertext) BinarySize() (size int) {
-	size++
+	size = codecHeaderSize + 1
 	if ct.MetaData != nil {
 		size += ct.MetaData.BinarySize()
 	}
@@ -203,6 +300,12 @@ func (ct *Ciphertext) WriteTo(w io.Writer) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if ct.MetaData != nil {
 
 			if inc, err = buffer.WriteUint8(w, 1); err != nil {
@@ -252,6 +355,12 @@ func (ct *Ciphertext) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		var hasMetaData uint8
 
 		if inc, err = buffer.ReadUint8(r, &hasMetaData); err != nil {
@@ -0,0 +1,70 @@
+package rlwe
+
+import (
+	"fmt"
+
+	"github.com/Pro7ech/lattigo/utils/buffer"
+)
+
+// CodecVersion is the version of the binary serialization format of the rlwe types.
+// It is written, along with a magic prefix, at the head of the serialization of the
+// [rlwe.Ciphertext], [rlwe.Plaintext], [rlwe.SecretKey], [rlwe.PublicKey],
+// [rlwe.EvaluationKey] and [rlwe.GaloisKey] types, so that data serialized with an
+// incompatible version of the library is rejected with a clear error instead of
+// being silently misparsed.
+const CodecVersion uint8 = 1
+
+// codecMagic is the magic prefix identifying the serialization of the rlwe types.
+var codecMagic = [4]byte{'r', 'l', 'w', 'e'}
+
+// codecHeaderSize is the size in bytes of the serialization header (magic prefix + version).
+const codecHeaderSize = 5
+
+// writeCodecHeader writes the serialization header (magic prefix + version) on w.
+func writeCodecHeader(w buffer.Writer) (n int64, err error) {
+
+	var inc int64
+
+	if inc, err = buffer.Write(w, codecMagic[:]); err != nil {
+		return n + inc, err
+	}
+
+	n += inc
+
+	if inc, err = buffer.WriteUint8(w, CodecVersion); err != nil {
+		return n + inc, err
+	}
+
+	return n + inc, nil
+}
+
+// readCodecHeader reads and validates the serialization header (magic prefix + version)
+// from r, returning an error describing how to migrate the data if it does not match.
+func readCodecHeader(r buffer.Reader) (n int64, err error) {
+
+	var inc int64
+
+	var magic [4]byte
+	if inc, err = buffer.Read(r, magic[:]); err != nil {
+		return n + inc, err
+	}
+
+	n += inc
+
+	if magic != codecMagic {
+		return n, fmt.Errorf("invalid serialization header: magic prefix %q != %q: the data was either not serialized by this library or predates serialization versioning, in which case it must be re-serialized with a release supporting both formats", magic, codecMagic)
+	}
+
+	var version uint8
+	if inc, err = buffer.ReadUint8(r, &version); err != nil {
+		return n + inc, err
+	}
+
+	n += inc
+
+	if version != CodecVersion {
+		return n, fmt.Errorf("unsupported serialization version %d != %d: the data must be re-serialized with a release whose [rlwe.CodecVersion] matches", version, CodecVersion)
+	}
+
+	return n, nil
+}
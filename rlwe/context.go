@@ -0,0 +1,199 @@
+package rlwe
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Names of the entries of the archive produced by [SaveContext].
+const (
+	contextManifestName       = "manifest.json"
+	contextParametersName     = "parameters.json"
+	contextSecretKeyName      = "secret_key.bin"
+	contextEvaluationKeysName = "evaluation_keys.bin"
+)
+
+// contextManifestVersion is the version of the archive layout, stored in the
+// manifest so that future layout changes can be detected on load.
+const contextManifestVersion = 1
+
+// contextManifest describes the content of the archive produced by [SaveContext].
+type contextManifest struct {
+	Version int
+	Entries []string
+}
+
+// SaveContext packs the parameters, secret key and evaluation keys into a single
+// tar archive written on w, prefixed with a JSON manifest listing its entries.
+// The parameters are stored as JSON (see [Parameters.MarshalJSON]) and the keys
+// with their respective binary marshallers. Both sk and evk are optional and
+// omitted from the archive if nil.
+//
+// The archive can be read back with [LoadContext].
+func SaveContext(w io.Writer, params Parameters, sk *SecretKey, evk *MemEvaluationKeySet) (err error) {
+
+	entries := []string{contextParametersName}
+	if sk != nil {
+		entries = append(entries, contextSecretKeyName)
+	}
+	if evk != nil {
+		entries = append(entries, contextEvaluationKeysName)
+	}
+
+	manifest, err := json.Marshal(contextManifest{Version: contextManifestVersion, Entries: entries})
+	if err != nil {
+		return fmt.Errorf("cannot SaveContext: %w", err)
+	}
+
+	parameters, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("cannot SaveContext: %w", err)
+	}
+
+	tw := tar.NewWriter(w)
+
+	write := func(name string, data []byte) (err error) {
+		if err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data))}); err != nil {
+			return fmt.Errorf("cannot SaveContext: %s: %w", name, err)
+		}
+		if _, err = tw.Write(data); err != nil {
+			return fmt.Errorf("cannot SaveContext: %s: %w", name, err)
+		}
+		return
+	}
+
+	if err = write(contextManifestName, manifest); err != nil {
+		return
+	}
+
+	if err = write(contextParametersName, parameters); err != nil {
+		return
+	}
+
+	if sk != nil {
+		var data []byte
+		if data, err = sk.MarshalBinary(); err != nil {
+			return fmt.Errorf("cannot SaveContext: %w", err)
+		}
+		if err = write(contextSecretKeyName, data); err != nil {
+			return
+		}
+	}
+
+	if evk != nil {
+		var data []byte
+		if data, err = evk.MarshalBinary(); err != nil {
+			return fmt.Errorf("cannot SaveContext: %w", err)
+		}
+		if err = write(contextEvaluationKeysName, data); err != nil {
+			return
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return fmt.Errorf("cannot SaveContext: %w", err)
+	}
+
+	return
+}
+
+// LoadContext reads back an archive produced by [SaveContext], returning the
+// parameters along with the secret key and evaluation keys, which are nil if
+// absent from the archive. The keys are checked for consistency against the
+// parameters (matching ring degree and moduli chains) and a descriptive error
+// is returned if they do not match, e.g. if the entries of the archive were
+// produced from different parameters.
+func LoadContext(r io.Reader) (params Parameters, sk *SecretKey, evk *MemEvaluationKeySet, err error) {
+
+	tr := tar.NewReader(r)
+
+	files := map[string][]byte{}
+	for {
+		var hdr *tar.Header
+		if hdr, err = tr.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: %w", err)
+		}
+		var data []byte
+		if data, err = io.ReadAll(tr); err != nil {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: %s: %w", hdr.Name, err)
+		}
+		files[hdr.Name] = data
+	}
+
+	var manifest contextManifest
+	data, ok := files[contextManifestName]
+	if !ok {
+		return params, nil, nil, fmt.Errorf("cannot LoadContext: missing entry %s", contextManifestName)
+	}
+
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return params, nil, nil, fmt.Errorf("cannot LoadContext: %s: %w", contextManifestName, err)
+	}
+
+	if manifest.Version != contextManifestVersion {
+		return params, nil, nil, fmt.Errorf("cannot LoadContext: unsupported manifest version %d (expected %d)", manifest.Version, contextManifestVersion)
+	}
+
+	for _, entry := range manifest.Entries {
+		if _, ok = files[entry]; !ok {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: entry %s listed in the manifest but missing from the archive", entry)
+		}
+	}
+
+	if data, ok = files[contextParametersName]; !ok {
+		return params, nil, nil, fmt.Errorf("cannot LoadContext: missing entry %s", contextParametersName)
+	}
+
+	if err = json.Unmarshal(data, &params); err != nil {
+		return params, nil, nil, fmt.Errorf("cannot LoadContext: %s: %w", contextParametersName, err)
+	}
+
+	if data, ok = files[contextSecretKeyName]; ok {
+		sk = new(SecretKey)
+		if err = sk.UnmarshalBinary(data); err != nil {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: %s: %w", contextSecretKeyName, err)
+		}
+		if sk.N() != params.N() || sk.LevelQ() != params.MaxLevelQ() || sk.LevelP() != params.MaxLevelP() {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: secret key has (N, LevelQ, LevelP) = (%d, %d, %d) but the parameters expect (%d, %d, %d)",
+				sk.N(), sk.LevelQ(), sk.LevelP(), params.N(), params.MaxLevelQ(), params.MaxLevelP())
+		}
+	}
+
+	if data, ok = files[contextEvaluationKeysName]; ok {
+		evk = new(MemEvaluationKeySet)
+		if err = evk.UnmarshalBinary(data); err != nil {
+			return params, nil, nil, fmt.Errorf("cannot LoadContext: %s: %w", contextEvaluationKeysName, err)
+		}
+		if rlk := evk.RelinearizationKey; rlk != nil {
+			if err = checkEvaluationKeyContext(params, "relinearization key", &rlk.EvaluationKey); err != nil {
+				return params, nil, nil, fmt.Errorf("cannot LoadContext: %w", err)
+			}
+		}
+		for galEl, gk := range evk.GaloisKeys {
+			if err = checkEvaluationKeyContext(params, fmt.Sprintf("galois key %d", galEl), &gk.EvaluationKey); err != nil {
+				return params, nil, nil, fmt.Errorf("cannot LoadContext: %w", err)
+			}
+		}
+	}
+
+	return
+}
+
+// checkEvaluationKeyContext checks that the dimensions of the evaluation key are
+// compatible with the parameters.
+func checkEvaluationKeyContext(params Parameters, name string, evk *EvaluationKey) (err error) {
+	if evk.LogN() != params.LogN() {
+		return fmt.Errorf("%s has LogN=%d but the parameters expect LogN=%d", name, evk.LogN(), params.LogN())
+	}
+	if evk.LevelQ() > params.MaxLevelQ() {
+		return fmt.Errorf("%s has LevelQ=%d > %d allowed by the parameters", name, evk.LevelQ(), params.MaxLevelQ())
+	}
+	if evk.LevelP() > params.MaxLevelP() {
+		return fmt.Errorf("%s has LevelP=%d > %d allowed by the parameters", name, evk.LevelP(), params.MaxLevelP())
+	}
+	return
+}
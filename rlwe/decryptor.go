@@ -2,8 +2,10 @@ package rlwe
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/Pro7ech/lattigo/ring"
+	"github.com/Pro7ech/lattigo/utils/sampling"
 )
 
 // Decryptor is a structure used to decrypt [rlwe.Ciphertext].
@@ -67,6 +69,36 @@ func (d Decryptor) Decrypt(ct *Ciphertext, pt *Plaintext) {
 	}
 }
 
+// DecryptChecked decrypts an [rlwe.Ciphertext] and writes the result on an [rlwe.Plaintext],
+// like [Decryptor.Decrypt], and additionally checks that the log2 of the maximum absolute
+// norm of the decryption (message + noise, before decoding) is at most maxNoiseLog2.
+// If the bound is exceeded an error is returned: when a circuit exhausts its noise budget
+// the noise wraps around modulo Q and the decryption becomes uniform in [-Q/2, Q/2], raising
+// its maximum norm to about log2(Q), thus a bound a few bits above the largest expected
+// legitimate decryption turns an otherwise silent decryption failure into an explicit error.
+// The plaintext is written in all cases so that the caller may still inspect it.
+func (d Decryptor) DecryptChecked(ct *Ciphertext, pt *Plaintext, maxNoiseLog2 float64) (err error) {
+
+	d.Decrypt(ct, pt)
+
+	rQ := d.params.RingQ().AtLevel(pt.LevelQ())
+
+	buff := pt.Q
+	if pt.IsNTT {
+		rQ.INTT(pt.Q, d.buff)
+		buff = d.buff
+	}
+
+	values := make([]big.Int, d.params.N())
+	ring.PolyToBigintCentered(rQ, nil, buff, nil, 1, values)
+
+	if _, _, max := NormStats(values); max > maxNoiseLog2 {
+		return fmt.Errorf("cannot DecryptChecked: log2 of the maximum norm of the decryption is %f > %f", max, maxNoiseLog2)
+	}
+
+	return
+}
+
 func (d *Decryptor) decrypt(r ring.RNSRing, ct []ring.RNSPoly, pt, sk ring.RNSPoly, isNTT bool) {
 
 	degree := len(ct) - 1
@@ -102,6 +134,70 @@ func (d *Decryptor) decrypt(r ring.RNSRing, ct []ring.RNSPoly, pt, sk ring.RNSPo
 	}
 }
 
+// DecryptToShares decrypts an [rlwe.Ciphertext] and returns the result as nParties additive
+// secret-shares, i.e. a list of [rlwe.Plaintext] such that the decrypted plaintext is the sum
+// of all the shares. A fresh smudging (flooding) noise of standard deviation eFlood is added
+// to each share.
+// This provides a lightweight building block for custom MPC protocols, without requiring the
+// full collective key-switching machinery of the mhe package.
+//
+// SECURITY: the shares are only ever to be combined by summation, an incomplete subset of them
+// must never be revealed as a decryption. The summed decryption noise depends on the secret-key
+// (and on the evaluated circuit), thus eFlood must be chosen large enough to statistically mask
+// it from the parties learning the reconstructed plaintext (see https://eprint.iacr.org/2017/257
+// for guidelines on choosing the smudging noise).
+func (d Decryptor) DecryptToShares(ct *Ciphertext, nParties int, eFlood float64) (shares []*Plaintext) {
+
+	if nParties < 1 {
+		panic(fmt.Errorf("nParties must be at least 1 but is %d", nParties))
+	}
+
+	LevelQ := ct.LevelQ()
+	rQ := d.params.RingQ().AtLevel(LevelQ)
+
+	// The last share receives the decryption m + e
+	last := NewPlaintext(d.params, LevelQ, -1)
+	d.Decrypt(ct, last)
+
+	shares = make([]*Plaintext, nParties)
+	shares[nParties-1] = last
+
+	source := sampling.NewSource(newSeed())
+
+	xa := ring.NewUniformSampler(source, rQ.ModuliChain()).AtLevel(LevelQ)
+
+	for i := 0; i < nParties-1; i++ {
+
+		share := NewPlaintext(d.params, LevelQ, -1)
+		*share.MetaData = *last.MetaData
+
+		// Uniform masking share, subtracted from the last share
+		// so that the sum of the shares is left unchanged
+		xa.Read(share.Q)
+		rQ.Sub(last.Q, share.Q, last.Q)
+
+		shares[i] = share
+	}
+
+	if eFlood != 0 {
+
+		// This is lightweight, no pre-computation
+		xe := ring.NewGaussianSampler(source, rQ.ModuliChain(), ring.DiscreteGaussian{Sigma: eFlood, Bound: 6 * eFlood}).AtLevel(LevelQ)
+
+		for i := range shares {
+			if ct.IsNTT {
+				xe.Read(d.buff)
+				rQ.NTT(d.buff, d.buff)
+				rQ.Add(shares[i].Q, d.buff, shares[i].Q)
+			} else {
+				xe.ReadAndAdd(shares[i].Q)
+			}
+		}
+	}
+
+	return
+}
+
 // ShallowCopy creates a shallow copy of the receiver in which all the read-only data-
 // structures are shared with the receiver and the temporary buffers are reallocated.
 // The receiver and the returned object can be used concurrently.
@@ -69,14 +69,14 @@ func (enc Encryptor) GetRLWEParameters() *Parameters {
 
 func newEncryptor(params Parameters) *Encryptor {
 
-	xeSampler, err := ring.NewSampler(sampling.NewSource(sampling.NewSeed()), params.RingQ().ModuliChain(), params.Xe())
+	xeSampler, err := ring.NewSampler(sampling.NewSource(newSeed()), params.RingQ().ModuliChain(), params.Xe())
 
 	// Sanity check, this error should not happen.
 	if err != nil {
 		panic(fmt.Errorf("newEncryptor: %w", err))
 	}
 
-	xuSampler, err := ring.NewSampler(sampling.NewSource(sampling.NewSeed()), params.RingQ().ModuliChain(), params.Xs())
+	xuSampler, err := ring.NewSampler(sampling.NewSource(newSeed()), params.RingQ().ModuliChain(), params.Xs())
 
 	// Sanity check, this error should not happen.
 	if err != nil {
@@ -86,7 +86,7 @@ func newEncryptor(params Parameters) *Encryptor {
 	var xaPSampler ring.Sampler
 
 	if params.RingP() != nil {
-		xaPSampler = ring.NewUniformSampler(sampling.NewSource(sampling.NewSeed()), params.RingP().ModuliChain())
+		xaPSampler = ring.NewUniformSampler(sampling.NewSource(newSeed()), params.RingP().ModuliChain())
 	}
 
 	return &Encryptor{
@@ -94,7 +94,7 @@ func newEncryptor(params Parameters) *Encryptor {
 		EncryptorBuffers: newEncryptorBuffers(params),
 		xeSampler:        xeSampler,
 		xuSampler:        xuSampler,
-		xaQSampler:       ring.NewUniformSampler(sampling.NewSource(sampling.NewSeed()), params.RingQ().ModuliChain()),
+		xaQSampler:       ring.NewUniformSampler(sampling.NewSource(newSeed()), params.RingQ().ModuliChain()),
 		xaPSampler:       xaPSampler,
 	}
 }
@@ -211,6 +211,50 @@ func (enc Encryptor) Encrypt(pt *Plaintext, ct interface{}) (err error) {
 	return
 }
 
+// EncryptMany encrypts the batch of input [rlwe.Plaintext] using the stored encryption key
+// and writes the i-th encryption on cts[i]. A nil plaintext produces an encryption of zero.
+// See [Encryptor.Encrypt] for additional information about the encryption procedure.
+//
+// The internal sampling buffers are shared across the whole batch, amortizing the
+// per-encryption setup compared to instantiating one encryptor per encryption.
+//
+// The public randomness of the i-th ciphertext is read from a sub-source derived
+// deterministically from the state of the public randomness source at the time of the call.
+// In particular, if the public randomness is seeded (see [Encryptor.WithSeededPublicRandomness]),
+// the public randomness of the i-th ciphertext only depends on the seed and on i, and not on
+// the level or metadata of the other plaintexts of the batch.
+//
+// The method returns an error if len(pts) != len(cts) or if an encryption fails.
+func (enc Encryptor) EncryptMany(pts []*Plaintext, cts []*Ciphertext) (err error) {
+
+	if len(pts) != len(cts) {
+		return fmt.Errorf("cannot EncryptMany: len(pts) (%d) does not match len(cts) (%d)", len(pts), len(cts))
+	}
+
+	source := enc.xaQSampler.GetSource()
+
+	for i := range cts {
+
+		if cts[i] == nil {
+			return fmt.Errorf("cannot EncryptMany: cts[%d] is nil", i)
+		}
+
+		subSource := source.NewSource()
+
+		encSub := enc
+		encSub.xaQSampler = enc.xaQSampler.WithSource(subSource)
+		if enc.xaPSampler != nil {
+			encSub.xaPSampler = enc.xaPSampler.WithSource(subSource)
+		}
+
+		if err = encSub.Encrypt(pts[i], cts[i]); err != nil {
+			return fmt.Errorf("cannot EncryptMany: cts[%d]: %w", i, err)
+		}
+	}
+
+	return
+}
+
 // KeySwitch decrypts the ciphertext using the provided key and re-encrypts is using the
 // encryptor's key and adds a flooding noise with standard deviation eFlood.
 //
@@ -600,6 +644,35 @@ func (enc *Encryptor) WithSeededSecretRandomness(seed [32]byte) *Encryptor {
 	}
 }
 
+// WithSeededRandomness returns an instance of the receiver where all the randomness:
+// - Xe: noise when encrypting with [rlwe.SecretKey] or [rlwe.PublicKey]
+// - Xu: small vector when encrypting with [rlwe.PublicKey]
+// - Xa: public randomness when encrypting with [rlwe.SecretKey]
+// is seeded with seeds derived from the provided seed, making the output of the
+// encryption fully deterministic given the seed.
+func (enc *Encryptor) WithSeededRandomness(seed [32]byte) *Encryptor {
+	source := sampling.NewSource(seed)
+
+	xeSampler := enc.xeSampler.WithSource(source.NewSource())
+	xuSampler := enc.xuSampler.WithSource(source.NewSource())
+	xaQSampler := enc.xaQSampler.WithSource(source.NewSource())
+
+	var xaPSampler ring.Sampler
+	if enc.xaPSampler != nil {
+		xaPSampler = enc.xaPSampler.WithSource(source.NewSource())
+	}
+
+	return &Encryptor{
+		params:           enc.params,
+		EncryptorBuffers: enc.EncryptorBuffers,
+		encKey:           enc.encKey,
+		xeSampler:        xeSampler,
+		xuSampler:        xuSampler,
+		xaQSampler:       xaQSampler,
+		xaPSampler:       xaPSampler,
+	}
+}
+
 // WithSeededPublicRandomness returns an instance of the receiver were Xa
 // - Xu: public randomness when encrypting with [rlwe.SecretKey]
 // is seeded with the provided seed.
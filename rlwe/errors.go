@@ -0,0 +1,25 @@
+package rlwe
+
+import "errors"
+
+// Sentinel errors wrapped by the evaluators of the rlwe and he packages.
+// Callers can match them with [errors.Is] to programmatically distinguish
+// common failure modes, e.g. to generate a missing evaluation key or to
+// bootstrap a ciphertext whose level is exhausted before retrying.
+var (
+	// ErrInsufficientLevel is wrapped when the level of an operand is too
+	// low to carry out the requested operation.
+	ErrInsufficientLevel = errors.New("insufficient level")
+
+	// ErrMissingGaloisKey is wrapped when a required [GaloisKey] is not
+	// available in the [EvaluationKeySet].
+	ErrMissingGaloisKey = errors.New("missing GaloisKey")
+
+	// ErrMissingRelinKey is wrapped when the [RelinearizationKey] is not
+	// available in the [EvaluationKeySet].
+	ErrMissingRelinKey = errors.New("missing RelinearizationKey")
+
+	// ErrScaleMismatch is wrapped when the scales of the operands are
+	// incompatible with the requested operation.
+	ErrScaleMismatch = errors.New("scale mismatch")
+)
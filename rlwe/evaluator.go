@@ -37,6 +37,11 @@ type EvaluatorBuffers struct {
 	BuffGadgetQP    [2]ring.RNSPoly // Memory buffer for decomposed ciphertext
 	BuffModDownQ    ring.RNSPoly
 	BuffModDownP    ring.RNSPoly
+
+	// pool, if not nil, is the pool from which the ring.RNSPoly buffers
+	// were drawn and to which they are returned upon release.
+	pool   *ring.RNSPolyPool
+	pooled []*ring.RNSPoly
 }
 
 func NewEvaluatorBuffers(p Parameters) *EvaluatorBuffers {
@@ -94,6 +99,67 @@ func NewEvaluatorBuffers(p Parameters) *EvaluatorBuffers {
 	return buff
 }
 
+// NewEvaluatorBuffersFromPool is similar to [NewEvaluatorBuffers] but draws the
+// [ring.RNSPoly] buffers from the provided pool instead of allocating them.
+// The buffers can be returned to the pool with [Evaluator.Release].
+func NewEvaluatorBuffersFromPool(p Parameters, pool *ring.RNSPolyPool) *EvaluatorBuffers {
+
+	buff := new(EvaluatorBuffers)
+	buff.pool = pool
+
+	N := p.N()
+	MaxLevelQ := p.MaxLevelQ()
+
+	buff.BuffCt = NewCiphertext(p, 2, p.MaxLevel(), -1)
+
+	for i := range buff.BuffQ {
+		buff.BuffQ[i] = buff.fromPool(N, MaxLevelQ)
+	}
+
+	buff.BuffNTT = buff.fromPool(N, MaxLevelQ)
+	buff.BuffInvNTT = buff.fromPool(N, MaxLevelQ)
+
+	buff.BuffGadgetQP[0] = buff.fromPool(N, p.QCount())
+
+	if p.MaxLevelP() > -1 {
+
+		MaxLevelP := p.MaxLevelP()
+
+		for i := range buff.BuffP {
+			buff.BuffP[i] = buff.fromPool(N, MaxLevelP)
+		}
+
+		maxCoalescing := p.MaxCoalescing()
+
+		buff.BuffGadgetP = [2]ring.RNSPoly{
+			buff.fromPool(N, (maxCoalescing+1)*p.PCount()),
+			buff.fromPool(N, (maxCoalescing+1)*p.PCount()),
+		}
+
+		buff.BuffGadgetQP[1] = buff.fromPool(N, (maxCoalescing+1)*p.PCount())
+
+		buff.BuffGadgetCt = make([]uint64, new(GadgetCiphertext).BufferSize(p, 1, p.MaxLevelQ(), p.MaxLevelP(), DigitDecomposition{}))
+
+		buff.BuffModDownQ = buff.fromPool(N, p.QCount())
+		buff.BuffModDownP = buff.fromPool(N, (maxCoalescing+1)*p.PCount())
+	}
+
+	buff.BuffDigitDecomp = [2][]uint64{
+		make([]uint64, p.RingQ().N()),
+		make([]uint64, p.RingQ().N()),
+	}
+
+	return buff
+}
+
+// fromPool draws a new [ring.RNSPoly] from the pool of the receiver and tracks
+// it so that it can be returned to the pool upon release.
+func (buff *EvaluatorBuffers) fromPool(N, Level int) ring.RNSPoly {
+	p := buff.pool.Get(N, Level)
+	buff.pooled = append(buff.pooled, p)
+	return *p
+}
+
 // NewEvaluator creates a new Evaluator.
 func NewEvaluator(params ParameterProvider, evk EvaluationKeySet) (eval *Evaluator) {
 	eval = new(Evaluator)
@@ -153,6 +219,23 @@ func (eval *Evaluator) GetRLWEParameters() *Parameters {
 	return &eval.params
 }
 
+// MissingGaloisKeys returns the sub-list of the given Galois elements for which no
+// GaloisKey is available in the EvaluationKeySet. It enables methods consuming
+// several GaloisKeys to validate, up front, that all the required keys are present,
+// instead of failing in the middle of the evaluation.
+func (eval Evaluator) MissingGaloisKeys(galEls []uint64) (missing []uint64) {
+	for _, galEl := range galEls {
+		if eval.EvaluationKeySet == nil {
+			missing = append(missing, galEl)
+			continue
+		}
+		if _, err := eval.GetGaloisKey(galEl); err != nil {
+			missing = append(missing, galEl)
+		}
+	}
+	return
+}
+
 // CheckAndGetGaloisKey returns an error if the GaloisKey for the given Galois element is missing or the EvaluationKey interface is nil.
 func (eval Evaluator) CheckAndGetGaloisKey(galEl uint64) (evk *GaloisKey, err error) {
 	if eval.EvaluationKeySet != nil {
@@ -160,7 +243,7 @@ func (eval Evaluator) CheckAndGetGaloisKey(galEl uint64) (evk *GaloisKey, err er
 			return nil, fmt.Errorf("%w: key for galEl %d = 5^{%d} key is missing", err, galEl, eval.params.SolveDiscreteLogGaloisElement(galEl))
 		}
 	} else {
-		return nil, fmt.Errorf("evaluation key interface is nil")
+		return nil, fmt.Errorf("%w: evaluation key interface is nil", ErrMissingGaloisKey)
 	}
 
 	if eval.automorphismIndex == nil {
@@ -184,7 +267,7 @@ func (eval Evaluator) CheckAndGetRelinearizationKey() (evk *RelinearizationKey,
 			return nil, fmt.Errorf("%w: relineariztion key is missing", err)
 		}
 	} else {
-		return nil, fmt.Errorf("evaluation key interface is nil")
+		return nil, fmt.Errorf("%w: evaluation key interface is nil", ErrMissingRelinKey)
 	}
 
 	return
@@ -285,12 +368,21 @@ func (eval Evaluator) InitOutputUnaryOp(op0, opOut *Ciphertext) (degree, level i
 }
 
 // ShallowCopy creates a shallow copy of this Evaluator in which all the read-only data-structures are
-// shared with the receiver and the temporary buffers are reallocated. The receiver and the returned
-// Evaluators can be used concurrently.
+// shared with the receiver and the temporary buffers are reallocated. If the buffers of the receiver
+// are drawn from a pool (see [Evaluator.WithPool]), the buffers of the copy are drawn from the same
+// pool. The receiver and the returned Evaluators can be used concurrently.
 func (eval Evaluator) ShallowCopy() *Evaluator {
+
+	var buff *EvaluatorBuffers
+	if eval.EvaluatorBuffers != nil && eval.pool != nil {
+		buff = NewEvaluatorBuffersFromPool(eval.params, eval.pool)
+	} else {
+		buff = NewEvaluatorBuffers(eval.params)
+	}
+
 	return &Evaluator{
 		params:            eval.params,
-		EvaluatorBuffers:  NewEvaluatorBuffers(eval.params),
+		EvaluatorBuffers:  buff,
 		EvaluationKeySet:  eval.EvaluationKeySet,
 		automorphismIndex: eval.automorphismIndex,
 		RingP:             eval.RingP,
@@ -298,6 +390,36 @@ func (eval Evaluator) ShallowCopy() *Evaluator {
 	}
 }
 
+// WithPool creates a shallow copy of the receiver in which the temporary buffers are
+// drawn from the provided pool instead of being allocated. Calls to [Evaluator.ShallowCopy]
+// on the returned Evaluator will also draw their buffers from the pool, and calls to
+// [Evaluator.Release] return them, making the returned Evaluator suitable for transient
+// usage under heavy concurrent load (e.g. worker pools). The receiver and the returned
+// Evaluator can be used concurrently.
+func (eval Evaluator) WithPool(pool *ring.RNSPolyPool) *Evaluator {
+	return &Evaluator{
+		params:            eval.params,
+		EvaluatorBuffers:  NewEvaluatorBuffersFromPool(eval.params, pool),
+		EvaluationKeySet:  eval.EvaluationKeySet,
+		automorphismIndex: eval.automorphismIndex,
+		RingP:             eval.RingP,
+		Decomposers:       eval.Decomposers,
+	}
+}
+
+// Release returns the temporary buffers of the receiver to the pool they were drawn
+// from. It is a no-op if the buffers were not drawn from a pool (see [Evaluator.WithPool]).
+// The receiver, along with any Evaluator sharing its buffers (e.g. instantiated through
+// [Evaluator.WithKey]), must not be used after the call.
+func (eval *Evaluator) Release() {
+	if buff := eval.EvaluatorBuffers; buff != nil && buff.pool != nil {
+		for i := range buff.pooled {
+			buff.pool.Put(buff.pooled[i])
+		}
+		buff.pooled = nil
+	}
+}
+
 // WithKey creates a shallow copy of the receiver Evaluator for which the new EvaluationKey is evaluationKey
 // and where the temporary buffers are shared. The receiver and the returned Evaluators cannot be used concurrently.
 func (eval Evaluator) WithKey(evk EvaluationKeySet) *Evaluator {
@@ -333,6 +455,32 @@ func (eval Evaluator) AutomorphismIndex(galEl uint64) []uint64 {
 	return eval.automorphismIndex[galEl]
 }
 
+// PrecomputeAutomorphismIndex precomputes and caches the permutation look-up tables
+// for the given Galois elements. The tables are otherwise computed lazily the first
+// time an automorphism for a Galois element is applied, hence this method enables
+// warming the cache up front (e.g. at server startup) for rotation-heavy circuits.
+// Galois elements whose table is already cached are skipped.
+func (eval *Evaluator) PrecomputeAutomorphismIndex(galEls []uint64) {
+
+	if eval.automorphismIndex == nil {
+		eval.automorphismIndex = map[uint64][]uint64{}
+	}
+
+	N := eval.params.N()
+	NthRoot := eval.params.RingQ().NthRoot()
+
+	var err error
+	for _, galEl := range galEls {
+		if _, ok := eval.automorphismIndex[galEl]; ok {
+			continue
+		}
+		if eval.automorphismIndex[galEl], err = ring.AutomorphismNTTIndex(N, NthRoot, galEl); err != nil {
+			// Sanity check, this error should not happen.
+			panic(err)
+		}
+	}
+}
+
 func (eval Evaluator) GetEvaluatorBuffer() *EvaluatorBuffers {
 	return eval.EvaluatorBuffers
 }
@@ -356,6 +504,53 @@ func (eval Evaluator) ModDownNTT(LevelQ, LevelP int, p1Q, p1P, p2Q ring.RNSPoly)
 	rQ.ModDownNTT(rP, p1Q, p1P, eval.BuffModDownQ, eval.BuffModDownP, p2Q)
 }
 
+// ModUpToLevel extends the modulus basis of ct from its current level to targetLevel with
+// an exact RNS basis extension (centered reconstruction), i.e. without rescaling the
+// ciphertext. The extension is exact on the ciphertext coefficients, hence the underlying
+// encrypted value becomes [m + e]_Q seen modulo the larger modulus: the operation is only
+// meaningful if the magnitude of the encrypted message (plus noise) is smaller than the
+// original modulus Q, which is the user's responsibility to ensure.
+//
+// The method returns an error if targetLevel is not strictly larger than ct.Level() or if
+// targetLevel is larger than the maximum level of the parameters.
+func (eval *Evaluator) ModUpToLevel(ct *Ciphertext, targetLevel int) (err error) {
+
+	level := ct.Level()
+
+	if targetLevel <= level {
+		return fmt.Errorf("targetLevel (%d) must be strictly larger than ct.Level() (%d)", targetLevel, level)
+	}
+
+	if targetLevel > eval.params.MaxLevel() {
+		return fmt.Errorf("targetLevel (%d) cannot be larger than MaxLevel (%d)", targetLevel, eval.params.MaxLevel())
+	}
+
+	rQ := eval.params.RingQ().AtLevel(targetLevel)
+	rIn := rQ.AtLevel(level)
+	rExt := ring.RNSRing(rQ[level+1:])
+
+	ct.ResizeQ(targetLevel)
+
+	buffInvNTT := eval.BuffQ[0][:level+1]
+	buffModUp := eval.BuffQ[1][:level+1]
+
+	for i := range ct.Q {
+
+		pIn := ct.Q[i][:level+1]
+		pExt := ct.Q[i][level+1:]
+
+		if ct.IsNTT {
+			rIn.INTT(pIn, buffInvNTT)
+			rIn.ModUp(rExt, buffInvNTT, buffModUp, pExt)
+			rExt.NTT(pExt, pExt)
+		} else {
+			rIn.ModUp(rExt, pIn, buffModUp, pExt)
+		}
+	}
+
+	return
+}
+
 type HoistingBuffer structs.Vector[ring.Point]
 
 func (eval Evaluator) NewHoistingBuffer(LevelQ, LevelP int) (buf HoistingBuffer) {
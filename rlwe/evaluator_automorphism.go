@@ -55,6 +55,29 @@ func (eval Evaluator) Automorphism(ctIn *Ciphertext, galEl uint64, opOut *Cipher
 	return
 }
 
+// RotateByGaloisElementNew computes phi(ct), where phi is the map X -> X^galEl, and returns
+// the result on a new ciphertext. It is a thin wrapper around [Evaluator.Automorphism] for
+// users working directly with automorphisms (e.g. implementing a custom permutation network),
+// sparing them the inversion of the rotation -> Galois element mapping of the higher-level
+// evaluators. The method returns an error if the [GaloisKey] for galEl is not available in
+// the [EvaluationKeySet] or if ct degree is not equal to 1.
+func (eval Evaluator) RotateByGaloisElementNew(ct *Ciphertext, galEl uint64) (opOut *Ciphertext, err error) {
+
+	if galEl != 1 {
+		if _, err = eval.CheckAndGetGaloisKey(galEl); err != nil {
+			return nil, fmt.Errorf("cannot RotateByGaloisElementNew: %w", err)
+		}
+	}
+
+	opOut = NewCiphertext(eval.params, 1, ct.Level(), -1)
+
+	if err = eval.Automorphism(ct, galEl, opOut); err != nil {
+		return nil, fmt.Errorf("cannot RotateByGaloisElementNew: %w", err)
+	}
+
+	return
+}
+
 // AutomorphismHoisted is similar to Automorphism, except that it takes as input ctIn and c1DecompQP, where c1DecompQP is the RNS
 // decomposition of its element of degree 1. This decomposition can be obtained with DecomposeNTT.
 // The method requires that the corresponding RotationKey has been added to the Evaluator.
@@ -104,6 +127,47 @@ func (eval Evaluator) AutomorphismHoisted(ctIn *Ciphertext, buf HoistingBuffer,
 	return
 }
 
+// AutomorphismMany is similar to AutomorphismHoisted, except that it applies several Galois elements
+// sharing the same hoisted RNS decomposition of ctIn, writing phi_{galEl}(ctIn) on opOut[galEl] for
+// each galEl in galEls. Nil entries of opOut are allocated by the method.
+// The method validates up front that all the required GaloisKeys are present and returns a combined
+// error listing the missing Galois elements, rather than failing on the first.
+// The method will return an error if ctIn degree is not equal to 1.
+func (eval Evaluator) AutomorphismMany(ctIn *Ciphertext, buf HoistingBuffer, galEls []uint64, opOut map[uint64]*Ciphertext) (err error) {
+
+	if ctIn.Degree() != 1 {
+		return fmt.Errorf("cannot apply AutomorphismMany: input [rlwe.Ciphertext] must be of degree 1")
+	}
+
+	var missing []uint64
+	for _, galEl := range galEls {
+		if galEl == 1 {
+			continue
+		}
+
+		if _, err = eval.CheckAndGetGaloisKey(galEl); err != nil {
+			missing = append(missing, galEl)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("cannot apply AutomorphismMany: missing GaloisKeys for Galois elements %v", missing)
+	}
+
+	for _, galEl := range galEls {
+
+		if opOut[galEl] == nil {
+			opOut[galEl] = NewCiphertext(eval.params, 1, ctIn.Level(), -1)
+		}
+
+		if err = eval.AutomorphismHoisted(ctIn, buf, galEl, opOut[galEl]); err != nil {
+			return fmt.Errorf("cannot apply AutomorphismMany: %w", err)
+		}
+	}
+
+	return
+}
+
 // AutomorphismHoistedLazy is similar to AutomorphismHoisted, except that it returns a ciphertext modulo QP and scaled by P.
 // The method requires that the corresponding RotationKey has been added to the Evaluator.
 // Accepts `ctIn` in NTT and outside of NTT domain, but `ctQP` is always returned in the NTT domain.
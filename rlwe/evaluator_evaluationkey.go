@@ -92,6 +92,39 @@ func (eval Evaluator) ApplyEvaluationKey(ctIn *Ciphertext, evk *EvaluationKey, o
 	return
 }
 
+// KeySwitchNew switches the ciphertext ct, encrypted under a secret skA, to a new
+// ciphertext encrypting the same plaintext under a secret skB, using the provided
+// switching key swk, and returns the result in a new [rlwe.Ciphertext].
+//
+// The switching key is an [rlwe.EvaluationKey] encrypting skA under skB, generated
+// with [KeyGenerator.GenEvaluationKey](skA, skB). In a proxy re-encryption scenario,
+// party A generates swk from its own secret and the (secret or public, see
+// [KeyGenerator.GenEvaluationKey]) key of party B, and hands it to the proxy; the
+// proxy can then publicly re-encrypt ciphertexts of A to B with this method, without
+// learning the plaintexts.
+//
+// The method expects ct to be of degree 1 (i.e. not carrying a pending
+// relinearization) and of the same ring degree as the evaluator; switching between
+// ring degrees is supported by [Evaluator.ApplyEvaluationKey].
+func (eval Evaluator) KeySwitchNew(ct *Ciphertext, swk *EvaluationKey) (opOut *Ciphertext, err error) {
+
+	if ct.Degree() != 1 {
+		return nil, fmt.Errorf("cannot KeySwitchNew: ct degree should be 1 but is %d", ct.Degree())
+	}
+
+	if ct.N() != eval.params.N() {
+		return nil, fmt.Errorf("cannot KeySwitchNew: ct ring degree does not match evaluator params ring degree")
+	}
+
+	opOut = NewCiphertext(eval.params, 1, ct.Level(), -1)
+
+	if err = eval.ApplyEvaluationKey(ct, swk, opOut); err != nil {
+		return nil, fmt.Errorf("cannot KeySwitchNew: %w", err)
+	}
+
+	return
+}
+
 func (eval Evaluator) applyEvaluationKey(level int, ctIn *Ciphertext, evk *EvaluationKey, opOut *Ciphertext) {
 
 	if ctIn != opOut {
@@ -152,6 +185,77 @@ func (eval Evaluator) Relinearize(ctIn, opOut *Ciphertext) (err error) {
 	return
 }
 
+// RelinearizeFromSerialized deserializes a quadratic ciphertext from data and applies the
+// relinearization procedure on it, returning the result in opOut (see [Evaluator.Relinearize]).
+// Un-relinearized degree-2 ciphertexts are a valid transport unit: the node evaluating the
+// product can serialize the intermediate degree-2 state with [Ciphertext.MarshalBinary] (or
+// [Ciphertext.WriteTo]) and ship the relinearization to another node holding the
+// relinearization key.
+func (eval Evaluator) RelinearizeFromSerialized(data []byte, opOut *Ciphertext) (err error) {
+
+	ctIn := new(Ciphertext)
+	if err = ctIn.UnmarshalBinary(data); err != nil {
+		return fmt.Errorf("cannot RelinearizeFromSerialized: %w", err)
+	}
+
+	if err = eval.Relinearize(ctIn, opOut); err != nil {
+		return fmt.Errorf("cannot RelinearizeFromSerialized: %w", err)
+	}
+
+	return
+}
+
+// RelinearizeDegree applies the relinearization procedure on a ciphertext of arbitrary degree
+// and returns the result in opOut.
+// It takes as input a ciphertext of degree d >= 2, that decrypts with the key (1, sk, ..., sk^d),
+// and the relinearization keys rlks, where rlks[i] re-encrypts the term that decrypts using
+// sk^{i+2} to one that decrypts using sk (see [KeyGenerator.GenRelinearizationKeyDegree]), and
+// outputs a linear ciphertext that decrypts with the key (1, sk).
+// The method will return an error if:
+//   - The input ciphertext degree is smaller than 2.
+//   - Fewer than ctIn.Degree()-1 relinearization keys are provided.
+func (eval Evaluator) RelinearizeDegree(ctIn *Ciphertext, rlks []*RelinearizationKey, opOut *Ciphertext) (err error) {
+
+	degree := ctIn.Degree()
+
+	if degree < 2 {
+		return fmt.Errorf("cannot RelinearizeDegree: ctIn.Degree() should be at least 2 but is %d", degree)
+	}
+
+	if len(rlks) < degree-1 {
+		return fmt.Errorf("cannot RelinearizeDegree: ctIn.Degree()=%d requires %d relinearization keys but %d are provided", degree, degree-1, len(rlks))
+	}
+
+	level := min(ctIn.Level(), opOut.Level())
+
+	ringQ := eval.params.RingQ().AtLevel(level)
+
+	ctTmp := &Ciphertext{}
+	ctTmp.Vector = &ring.Vector{}
+	ctTmp.Q = eval.BuffQ[:2]
+	ctTmp.MetaData = ctIn.MetaData
+
+	for d := degree; d >= 2; d-- {
+
+		eval.GadgetProduct(level, ctIn.Q[d], ctIn.IsNTT, &rlks[d-2].GadgetCiphertext, ctTmp)
+
+		if d == degree {
+			ringQ.Add(ctIn.Q[0], ctTmp.Q[0], opOut.Q[0])
+			ringQ.Add(ctIn.Q[1], ctTmp.Q[1], opOut.Q[1])
+		} else {
+			ringQ.Add(opOut.Q[0], ctTmp.Q[0], opOut.Q[0])
+			ringQ.Add(opOut.Q[1], ctTmp.Q[1], opOut.Q[1])
+		}
+	}
+
+	opOut.ResizeQ(level)
+	opOut.ResizeSize(2)
+
+	*opOut.MetaData = *ctIn.MetaData
+
+	return
+}
+
 func (eval Evaluator) RelinearizeInplace(op *Ciphertext, c2 ring.RNSPoly) (err error) {
 
 	rQ := eval.params.RingQ().AtLevel(op.Level())
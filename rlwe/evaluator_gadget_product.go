@@ -67,6 +67,33 @@ func (eval Evaluator) GadgetProduct(LevelQ int, cx ring.RNSPoly, cxIsNTT bool, g
 	eval.ModDown(LevelQ, LevelP, ctTmp, ct)
 }
 
+// GadgetProductNoP evaluates poly x Gadget -> RLWE as [Evaluator.GadgetProduct] for a
+// gadget ciphertext generated without the auxiliary prime P, i.e. with
+// [EvaluationKeyParameters].LevelP = -1. The mod-up of the decomposed digits to P, the
+// accumulation of the P component and the final division by P are all skipped, which
+// roughly halves the cost of the product.
+//
+// The speed-up is paid in noise: with the auxiliary prime, each of the decomposition
+// digits contributes a noise variance of ~N/12 times the key noise (the gadget noise
+// being scaled down by P), whereas without it each digit contributes a variance of
+// ~N*B^2/12 times the key noise, where B is the digit basis (the largest q_i for the
+// pure RNS gadget, 2^{Log2Basis} if digit decomposition is used). It is only acceptable
+// when a large noise budget remains, e.g. for the last operation before a decryption.
+//
+// The method returns an error if gadgetCt carries an auxiliary prime component: the
+// scaling by P is baked into the key material at its generation and cannot be skipped
+// at evaluation time; the evaluation key must have been generated with LevelP = -1.
+func (eval Evaluator) GadgetProductNoP(LevelQ int, cx ring.RNSPoly, cxIsNTT bool, gadgetCt *GadgetCiphertext, ct *Ciphertext) (err error) {
+
+	if LevelP := gadgetCt.LevelP(); LevelP != -1 {
+		return fmt.Errorf("cannot GadgetProductNoP: gadgetCt.LevelP()=%d: the scaling by P is baked into the key at its generation, the evaluation key must be generated with LevelP = -1", LevelP)
+	}
+
+	eval.GadgetProduct(LevelQ, cx, cxIsNTT, gadgetCt, ct)
+
+	return
+}
+
 // ModDown takes elQP (mod QP) and returns elQ = (elQP/P) (mod Q).
 func (eval Evaluator) ModDown(LevelQ, LevelP int, elQP, elQ *Ciphertext) {
 
@@ -559,6 +586,57 @@ func (eval Evaluator) gadgetProductMultiplePLazyHoisted(LevelQ int, overwrite bo
 	}
 }
 
+// GadgetProductCoalesced evaluates poly x Gadget -> RLWE for each gadget ciphertext of gadgetCts,
+// storing the i-th product in cts[i]:
+//
+//	cts[i] = [<decomp(cx), gadgetCts[i][0]>, <decomp(cx), gadgetCts[i][1]>] mod Q
+//
+// The expensive decomposition of cx is computed once and shared across all the products
+// (e.g. a relinearization key and several Galois keys), which is the core optimization
+// behind the fast evaluation of linear transformations.
+//
+// buf must have been allocated with [Evaluator.NewHoistingBuffer] at LevelQ and
+// params.MaxLevelP(); its content is overwritten.
+//
+// If no auxiliary prime is used, the decomposition cannot be shared and the method
+// falls back to sequential calls to [Evaluator.GadgetProduct].
+//
+// The method returns an error if len(gadgetCts) != len(cts) or if a gadget ciphertext
+// uses digit decomposition on top of the RNS decomposition (unsupported by the hoisted
+// gadget product).
+func (eval Evaluator) GadgetProductCoalesced(LevelQ int, cx ring.RNSPoly, cxIsNTT bool, buf HoistingBuffer, gadgetCts []*GadgetCiphertext, cts []*Ciphertext) (err error) {
+
+	if len(gadgetCts) != len(cts) {
+		return fmt.Errorf("cannot GadgetProductCoalesced: len(gadgetCts)=%d != len(cts)=%d", len(gadgetCts), len(cts))
+	}
+
+	LevelP := eval.params.MaxLevelP()
+
+	if LevelP == -1 {
+		for i := range gadgetCts {
+			eval.GadgetProduct(LevelQ, cx, cxIsNTT, gadgetCts[i], cts[i])
+		}
+		return
+	}
+
+	for i := range gadgetCts {
+		if int(gadgetCts[i].DigitDecomposition.Type) != 0 {
+			return fmt.Errorf("cannot GadgetProductCoalesced: method is unsupported for DigitDecomposition != 0")
+		}
+		if gadgetCts[i].LevelP() != LevelP {
+			return fmt.Errorf("cannot GadgetProductCoalesced: gadgetCts[%d].LevelP()=%d != params.MaxLevelP()=%d", i, gadgetCts[i].LevelP(), LevelP)
+		}
+	}
+
+	eval.FillHoistingBuffer(LevelQ, LevelP, cx, cxIsNTT, buf)
+
+	for i := range gadgetCts {
+		eval.GadgetProductHoisted(LevelQ, buf, gadgetCts[i], cts[i])
+	}
+
+	return
+}
+
 // FillHoistingBuffer fills [HoistingBuffer] with the decomposition of cx.
 // Values in [HoistingBuffer] are in the NTT domain.
 func (eval Evaluator) FillHoistingBuffer(LevelQ, LevelP int, cx ring.RNSPoly, cxIsNTT bool, buf HoistingBuffer) {
@@ -587,14 +665,21 @@ func (eval Evaluator) FillHoistingBuffer(LevelQ, LevelP int, cx ring.RNSPoly, cx
 func (eval Evaluator) DecomposeSingleNTT(LevelQ, LevelP, BaseRNSDecompositionVectorSize int, c2NTT, c2InvNTT, c2QiQ, c2QiP ring.RNSPoly) {
 
 	rQ := eval.params.RingQAtLevel(LevelQ)
-	coalesce := max(0, (LevelP+1)/eval.params.PCount()-1)
-	rP := eval.RingP[coalesce].AtLevel(LevelP)
+
+	var coalesce int
+	var rP ring.RNSRing
+	if LevelP > -1 {
+		coalesce = max(0, (LevelP+1)/eval.params.PCount()-1)
+		rP = eval.RingP[coalesce].AtLevel(LevelP)
+	}
+
 	decomposer := eval.Decomposers[coalesce]
 
 	decomposer.DecomposeAndSplit(LevelQ, LevelP, BaseRNSDecompositionVectorSize, c2InvNTT, c2QiQ, c2QiP)
 
-	start := BaseRNSDecompositionVectorSize * (LevelP + 1)
-	end := start + (LevelP + 1)
+	// If LevelP = -1 (gadget without auxiliary prime), each digit spans a single prime q_i.
+	start := BaseRNSDecompositionVectorSize * max(1, LevelP+1)
+	end := start + max(1, LevelP+1)
 
 	// c2_qi = cx mod qi mod qi
 	for x := 0; x < LevelQ+1; x++ {
@@ -0,0 +1,58 @@
+package rlwe
+
+import (
+	"sync"
+
+	"github.com/Pro7ech/lattigo/utils"
+)
+
+// EvaluatorPool is a thread-safe registry of [Evaluator] that lazily instantiates and
+// hands out evaluators keyed by their [EvaluationKeySet]. Each evaluator is a
+// [Evaluator.ShallowCopy] of a common base evaluator: read-only data-structures are
+// shared while the temporary buffers are per-instance, so evaluators obtained from the
+// pool can be used concurrently. The pool encapsulates the shallow-copy discipline that
+// concurrent request handlers would otherwise have to manage themselves: a handler
+// calls [EvaluatorPool.Get], evaluates, and hands the evaluator back with
+// [EvaluatorPool.Put] for it to be recycled.
+type EvaluatorPool struct {
+	base  *Evaluator
+	pools sync.Map // EvaluationKeySet -> *sync.Pool
+}
+
+// NewEvaluatorPool instantiates a new EvaluatorPool.
+func NewEvaluatorPool(params ParameterProvider) *EvaluatorPool {
+	return &EvaluatorPool{base: NewEvaluator(params, nil)}
+}
+
+// Get returns an [Evaluator] instantiated with the provided [EvaluationKeySet]
+// (which can be nil), recycling an evaluator previously returned with
+// [EvaluatorPool.Put] for the same EvaluationKeySet if one is available, else lazily
+// instantiating a new one. The returned Evaluator is for the exclusive use of the
+// caller until it is handed back to the pool.
+func (p *EvaluatorPool) Get(evk EvaluationKeySet) *Evaluator {
+
+	if eval, ok := p.subPool(evk).Get().(*Evaluator); ok {
+		return eval
+	}
+
+	if utils.IsNil(evk) {
+		return p.base.ShallowCopy()
+	}
+
+	return p.base.ShallowCopy().WithKey(evk)
+}
+
+// Put hands an evaluator obtained from [EvaluatorPool.Get] back to the pool, making it
+// available to subsequent Get calls with the same [EvaluationKeySet]. The caller must
+// not use the evaluator after the call.
+func (p *EvaluatorPool) Put(eval *Evaluator) {
+	p.subPool(eval.EvaluationKeySet).Put(eval)
+}
+
+func (p *EvaluatorPool) subPool(evk EvaluationKeySet) *sync.Pool {
+	if pool, ok := p.pools.Load(evk); ok {
+		return pool.(*sync.Pool)
+	}
+	pool, _ := p.pools.LoadOrStore(evk, &sync.Pool{})
+	return pool.(*sync.Pool)
+}
@@ -0,0 +1,78 @@
+package rlwe
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatorPool(t *testing.T) {
+
+	params, err := NewParametersFromLiteral(ParametersLiteral{
+		LogN:    10,
+		LogQ:    []int{45, 35, 35},
+		LogP:    []int{50},
+		NTTFlag: true,
+	})
+	require.NoError(t, err)
+
+	kgen := NewKeyGenerator(params)
+	sk := kgen.GenSecretKeyNew()
+	enc := NewEncryptor(params, sk)
+	dec := NewDecryptor(params, sk)
+
+	evk := NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk))
+
+	pool := NewEvaluatorPool(params)
+
+	// Evaluators are instantiated with the requested key set
+	eval := pool.Get(evk)
+	require.Equal(t, EvaluationKeySet(evk), eval.EvaluationKeySet)
+
+	// Concurrently drawn evaluators do not share their buffers
+	other := pool.Get(evk)
+	require.NotSame(t, eval.EvaluatorBuffers, other.EvaluatorBuffers)
+	pool.Put(other)
+	pool.Put(eval)
+
+	// A nil key set is a valid key
+	evalNoKey := pool.Get(nil)
+	require.True(t, evalNoKey.EvaluationKeySet == nil)
+	pool.Put(evalNoKey)
+
+	// Evaluators drawn from the pool can be used concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			eval := pool.Get(evk)
+			defer pool.Put(eval)
+
+			// Builds a degree-2 ciphertext with a zero quadratic term: relinearizing
+			// it must return a degree-1 encryption of the same (zero) plaintext.
+			ct1 := NewCiphertext(params, 1, params.MaxLevel(), -1)
+			require.NoError(t, enc.EncryptZero(ct1))
+
+			ct2 := NewCiphertext(params, 2, params.MaxLevel(), -1)
+			*ct2.MetaData = *ct1.MetaData
+			ct2.Q[0].Copy(&ct1.Q[0])
+			ct2.Q[1].Copy(&ct1.Q[1])
+
+			require.NoError(t, eval.Relinearize(ct2, ct2))
+			require.Equal(t, 1, ct2.Degree())
+
+			pt := dec.DecryptNew(ct2)
+
+			ringQ := params.RingQ().AtLevel(ct2.Level())
+			if pt.IsNTT {
+				ringQ.INTT(pt.Q, pt.Q)
+			}
+
+			require.GreaterOrEqual(t, 30.0, ringQ.Stats(pt.Q)[0])
+		}()
+	}
+	wg.Wait()
+}
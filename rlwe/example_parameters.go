@@ -1,6 +1,24 @@
 package rlwe
 
 var (
+	// ExampleParametersLogN12LogQP109 is an example parameters set with logN=12 and logQP=109
+	// offering 128-bit of security.
+	ExampleParametersLogN12LogQP109 = ParametersLiteral{
+		LogN:    12,
+		LogQ:    []int{39, 31},
+		LogP:    []int{39},
+		NTTFlag: true,
+	}
+
+	// ExampleParametersLogN13LogQP218 is an example parameters set with logN=13 and logQP=218
+	// offering 128-bit of security.
+	ExampleParametersLogN13LogQP218 = ParametersLiteral{
+		LogN:    13,
+		LogQ:    []int{42, 33, 33, 33, 33},
+		LogP:    []int{44},
+		NTTFlag: true,
+	}
+
 	// ExampleParameterLogN14LogQP438 is an example parameters set with logN=14 and logQP=438
 	// offering 128-bit of security.
 	ExampleParametersLogN14LogQP438 = ParametersLiteral{
@@ -9,4 +27,44 @@ var (
 		P:       []uint64{0x3ffffffb80001, 0x4000000800001},
 		NTTFlag: true,
 	}
+
+	// ExampleParametersLogN15LogQP880 is an example parameters set with logN=15 and logQP=880
+	// offering 128-bit of security.
+	ExampleParametersLogN15LogQP880 = ParametersLiteral{
+		LogN:    15,
+		LogQ:    []int{47, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		LogP:    []int{47, 47, 47, 47},
+		NTTFlag: true,
+	}
+
+	// ExampleParametersLogN16LogQP1761 is an example parameters set with logN=16 and logQP=1761
+	// offering 128-bit of security.
+	ExampleParametersLogN16LogQP1761 = ParametersLiteral{
+		LogN:    16,
+		LogQ:    []int{56, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		LogP:    []int{55, 55, 55, 55},
+		NTTFlag: true,
+	}
 )
+
+// presets maps a name to its example [ParametersLiteral], enabling
+// configuration files to reference a vetted parameters set by name
+// (see [Preset]).
+var presets = map[string]ParametersLiteral{
+	"LogN12LogQP109":  ExampleParametersLogN12LogQP109,
+	"LogN13LogQP218":  ExampleParametersLogN13LogQP218,
+	"LogN14LogQP438":  ExampleParametersLogN14LogQP438,
+	"LogN15LogQP880":  ExampleParametersLogN15LogQP880,
+	"LogN16LogQP1761": ExampleParametersLogN16LogQP1761,
+}
+
+// Preset returns the example [ParametersLiteral] registered under the
+// given name, along with a boolean indicating if the name is registered.
+// All registered presets offer 128-bit of security for a ternary secret
+// and cover LogN from 12 to 16; scheme-specific fields (such as the
+// default scaling factor or the plaintext modulus) are left to be set
+// by the caller.
+func Preset(name string) (p ParametersLiteral, ok bool) {
+	p, ok = presets[name]
+	return
+}
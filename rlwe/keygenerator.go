@@ -22,6 +22,20 @@ func NewKeyGenerator(params ParameterProvider) *KeyGenerator {
 	}
 }
 
+// NewKeyGeneratorWithSeed creates a new KeyGenerator whose secret-key sampling, error sampling
+// and uniform "a" sampling are all deterministically derived from the provided seed: two
+// KeyGenerators instantiated with the same parameters and seed produce identical keys, provided
+// the keys are generated in the same order. This complements the seeded public randomness of the
+// Encryptor and is intended for reproducible tests or for deriving the same keys on multiple
+// machines from a shared seed.
+func NewKeyGeneratorWithSeed(params ParameterProvider, seed [32]byte) *KeyGenerator {
+	p := params.GetRLWEParameters()
+	return &KeyGenerator{
+		Encryptor: NewEncryptor(params, nil).WithSeededRandomness(seed),
+		Point:     *ring.NewPoint(p.N(), p.MaxLevelQ(), p.MaxLevelP()),
+	}
+}
+
 // ShallowCopy creates a shallow copy of the receiver in which ll athe read-only data-structures are
 // shared with the receiver and the temporary buffers are reallocated. The receiver and the returned
 // object can be used concurrently.
@@ -65,6 +79,33 @@ func (kgen KeyGenerator) GenSecretKeyWithHammingWeight(hw int, sk *SecretKey) {
 	kgen.GenSecretKeyFromSampler(Xs, sk)
 }
 
+// GenSparseSecretKeyNew generates a new sparse SecretKey with exactly h non-zero
+// coefficients, regardless of the secret distribution specified by the parameters.
+// It returns an error if h is not in [1, N]. This is intended for experimenting with
+// sparse-secret parameterizations (e.g. bootstrapping) without re-instantiating the
+// parameters with a different Xs.
+func (kgen KeyGenerator) GenSparseSecretKeyNew(h int) (sk *SecretKey, err error) {
+	sk = NewSecretKey(kgen.params)
+	if err = kgen.GenSparseSecretKey(h, sk); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// GenSparseSecretKey generates a sparse SecretKey with exactly h non-zero coefficients,
+// regardless of the secret distribution specified by the parameters.
+// It returns an error if h is not in [1, N].
+func (kgen KeyGenerator) GenSparseSecretKey(h int, sk *SecretKey) (err error) {
+
+	if h < 1 || h > kgen.params.N() {
+		return fmt.Errorf("cannot GenSparseSecretKey: h=%d is not in [1, N=%d]", h, kgen.params.N())
+	}
+
+	kgen.GenSecretKeyWithHammingWeight(h, sk)
+
+	return
+}
+
 func (kgen KeyGenerator) GenSecretKeyFromSampler(sampler ring.Sampler, sk *SecretKey) {
 
 	LevelQ := sk.LevelQ()
@@ -129,6 +170,42 @@ func (kgen KeyGenerator) GenRelinearizationKey(sk *SecretKey, rlk *Relinearizati
 	kgen.genEvaluationKey(kgen.BuffQ[2], sk.Point, &rlk.EvaluationKey)
 }
 
+// GenRelinearizationKeyDegree generates the set of EvaluationKeys that will be used to relinearize
+// Ciphertexts of degree up to maxDegree: the i-th key re-encrypts the term that decrypts using
+// sk^{i+2} to one that decrypts using sk.
+// Such keys enable the relinearization of higher-degree ciphertexts (e.g. a triple product
+// evaluated without intermediate relinearization) at the cost of storing maxDegree-1 keys.
+// See [Evaluator.RelinearizeDegree].
+func (kgen KeyGenerator) GenRelinearizationKeyDegree(sk *SecretKey, maxDegree int, evkParams ...EvaluationKeyParameters) (rlks []*RelinearizationKey) {
+
+	if maxDegree < 2 {
+		panic(fmt.Errorf("maxDegree must be at least 2 but is %d", maxDegree))
+	}
+
+	levelQ, levelP, dd := ResolveEvaluationKeyParameters(kgen.params, evkParams)
+
+	rQ := kgen.params.RingQ().AtLevel(levelQ)
+
+	// skPow = sk^2 (NTT + Montgomery domain)
+	skPow := rQ.NewRNSPoly()
+	rQ.MulCoeffsMontgomery(sk.Q, sk.Q, skPow)
+
+	rlks = make([]*RelinearizationKey, maxDegree-1)
+
+	for d := 2; d <= maxDegree; d++ {
+
+		rlk := &RelinearizationKey{EvaluationKey: EvaluationKey{GadgetCiphertext: *NewGadgetCiphertext(kgen.params, 1, levelQ, levelP, dd)}}
+		kgen.genEvaluationKey(skPow, sk.Point, &rlk.EvaluationKey)
+		rlks[d-2] = rlk
+
+		if d != maxDegree {
+			rQ.MulCoeffsMontgomery(skPow, sk.Q, skPow)
+		}
+	}
+
+	return
+}
+
 // GenGaloisKeyNew generates a new GaloisKey, enabling the automorphism X^{i} -> X^{i * galEl}.
 func (kgen KeyGenerator) GenGaloisKeyNew(galEl uint64, sk *SecretKey, evkParams ...EvaluationKeyParameters) (gk *GaloisKey) {
 	levelQ, levelP, dd := ResolveEvaluationKeyParameters(kgen.params, evkParams)
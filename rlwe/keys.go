@@ -2,6 +2,7 @@ package rlwe
 
 import (
 	"bufio"
+	"crypto/sha256"
 	"fmt"
 	"io"
 
@@ -67,7 +68,7 @@ func (sk *SecretKey) AsPlaintext() *Plaintext {
 
 // BinarySize returns the serialized size of the object in bytes.
 func (sk SecretKey) BinarySize() (size int) {
-	return sk.MetaData.BinarySize() + sk.Point.BinarySize()
+	return codecHeaderSize + sk.MetaData.BinarySize() + sk.Point.BinarySize()
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -87,6 +88,12 @@ func (sk SecretKey) WriteTo(w io.Writer) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = sk.MetaData.WriteTo(w); err != nil {
 			return n + inc, err
 		}
@@ -120,6 +127,12 @@ func (sk *SecretKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = sk.MetaData.ReadFrom(r); err != nil {
 			return n + inc, err
 		}
@@ -201,7 +214,7 @@ func (pk *PublicKey) AsCiphertext() *Ciphertext {
 
 // BinarySize returns the serialized size of the object in bytes.
 func (pk PublicKey) BinarySize() (size int) {
-	return pk.MetaData.BinarySize() + pk.Vector.BinarySize()
+	return codecHeaderSize + pk.MetaData.BinarySize() + pk.Vector.BinarySize()
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -221,6 +234,12 @@ func (pk PublicKey) WriteTo(w io.Writer) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = pk.MetaData.WriteTo(w); err != nil {
 			return n + inc, err
 		}
@@ -254,6 +273,12 @@ func (pk *PublicKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = pk.MetaData.ReadFrom(r); err != nil {
 			return n + inc, err
 		}
@@ -367,6 +392,92 @@ func (evk EvaluationKey) Equal(other *EvaluationKey) bool {
 	return evk.GadgetCiphertext.Equal(&other.GadgetCiphertext)
 }
 
+// BinarySize returns the serialized size of the object in bytes.
+func (evk EvaluationKey) BinarySize() (size int) {
+	return codecHeaderSize + evk.GadgetCiphertext.BinarySize()
+}
+
+// WriteTo writes the object on an io.Writer. It implements the io.WriterTo
+// interface, and will write exactly object.BinarySize() bytes on w.
+//
+// Unless w implements the buffer.Writer interface (see lattigo/utils/buffer/writer.go),
+// it will be wrapped into a bufio.Writer. Since this requires allocations, it
+// is preferable to pass a buffer.Writer directly:
+//
+//   - When writing multiple times to a io.Writer, it is preferable to first wrap the
+//     io.Writer in a pre-allocated bufio.Writer.
+//   - When writing to a pre-allocated var b []byte, it is preferable to pass
+//     buffer.NewBuffer(b) as w (see lattigo/utils/buffer/buffer.go).
+func (evk EvaluationKey) WriteTo(w io.Writer) (n int64, err error) {
+	switch w := w.(type) {
+	case buffer.Writer:
+
+		var inc int64
+
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = evk.GadgetCiphertext.WriteTo(w); err != nil {
+			return n + inc, err
+		}
+
+		return n + inc, err
+	default:
+		return evk.WriteTo(bufio.NewWriter(w))
+	}
+}
+
+// ReadFrom reads on the object from an io.Writer. It implements the
+// io.ReaderFrom interface.
+//
+// Unless r implements the buffer.Reader interface (see see lattigo/utils/buffer/reader.go),
+// it will be wrapped into a bufio.Reader. Since this requires allocation, it
+// is preferable to pass a buffer.Reader directly:
+//
+//   - When reading multiple values from a io.Reader, it is preferable to first
+//     first wrap io.Reader in a pre-allocated bufio.Reader.
+//   - When reading from a var b []byte, it is preferable to pass a buffer.NewBuffer(b)
+//     as w (see lattigo/utils/buffer/buffer.go).
+func (evk *EvaluationKey) ReadFrom(r io.Reader) (n int64, err error) {
+	switch r := r.(type) {
+	case buffer.Reader:
+
+		var inc int64
+
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
+		if inc, err = evk.GadgetCiphertext.ReadFrom(r); err != nil {
+			return n + inc, err
+		}
+
+		return n + inc, err
+
+	default:
+		return evk.ReadFrom(bufio.NewReader(r))
+	}
+}
+
+// MarshalBinary encodes the object into a binary form on a newly allocated slice of bytes.
+func (evk EvaluationKey) MarshalBinary() (data []byte, err error) {
+	buf := buffer.NewBufferSize(evk.BinarySize())
+	_, err = evk.WriteTo(buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes a slice of bytes generated by
+// MarshalBinary or WriteTo on the object.
+func (evk *EvaluationKey) UnmarshalBinary(p []byte) (err error) {
+	_, err = evk.ReadFrom(buffer.NewBuffer(p))
+	return
+}
+
 // RelinearizationKey is type of evaluation key used for ciphertext multiplication compactness.
 // The Relinearization key encrypts s^{2} under s and is used to homomorphically re-encrypt the
 // degree 2 term of a ciphertext (the term that decrypt with s^{2}) into a degree 1 term
@@ -463,7 +574,7 @@ func (gk GaloisKey) Clone() *GaloisKey {
 
 // BinarySize returns the serialized size of the object in bytes.
 func (gk GaloisKey) BinarySize() (size int) {
-	return gk.EvaluationKey.BinarySize() + 16
+	return codecHeaderSize + gk.GadgetCiphertext.BinarySize() + 16
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
@@ -483,6 +594,12 @@ func (gk GaloisKey) WriteTo(w io.Writer) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = buffer.WriteUint64(w, gk.GaloisElement); err != nil {
 			return n + inc, err
 		}
@@ -495,7 +612,7 @@ func (gk GaloisKey) WriteTo(w io.Writer) (n int64, err error) {
 
 		n += inc
 
-		if inc, err = gk.EvaluationKey.WriteTo(w); err != nil {
+		if inc, err = gk.GadgetCiphertext.WriteTo(w); err != nil {
 			return n + inc, err
 		}
 
@@ -525,6 +642,12 @@ func (gk *GaloisKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if inc, err = buffer.ReadUint64(r, &gk.GaloisElement); err != nil {
 			return n + inc, err
 		}
@@ -537,7 +660,7 @@ func (gk *GaloisKey) ReadFrom(r io.Reader) (n int64, err error) {
 
 		n += inc
 
-		if inc, err = gk.EvaluationKey.ReadFrom(r); err != nil {
+		if inc, err = gk.GadgetCiphertext.ReadFrom(r); err != nil {
 			return n + inc, err
 		}
 
@@ -595,11 +718,26 @@ func NewMemEvaluationKeySet(relinKey *RelinearizationKey, galoisKeys ...*GaloisK
 	return eks
 }
 
+// AddGaloisKey adds the provided GaloisKey to the set, overwriting any key already
+// present for the same Galois element. The key is shared, not copied.
+func (evk *MemEvaluationKeySet) AddGaloisKey(gk *GaloisKey) {
+	if evk.GaloisKeys == nil {
+		evk.GaloisKeys = map[uint64]*GaloisKey{}
+	}
+	evk.GaloisKeys[gk.GaloisElement] = gk
+}
+
+// SetRelinearizationKey sets the RelinearizationKey of the set, overwriting any key
+// already present. The key is shared, not copied.
+func (evk *MemEvaluationKeySet) SetRelinearizationKey(rlk *RelinearizationKey) {
+	evk.RelinearizationKey = rlk
+}
+
 // GetGaloisKey retrieves the Galois key for the automorphism X^{i} -> X^{i*galEl}.
 func (evk MemEvaluationKeySet) GetGaloisKey(galEl uint64) (gk *GaloisKey, err error) {
 	var ok bool
 	if gk, ok = evk.GaloisKeys[galEl]; !ok {
-		return nil, fmt.Errorf("GaloisKey[%d] is nil", galEl)
+		return nil, fmt.Errorf("%w: GaloisKey[%d] is not available in the set", ErrMissingGaloisKey, galEl)
 	}
 
 	return
@@ -630,7 +768,51 @@ func (evk MemEvaluationKeySet) GetRelinearizationKey() (rk *RelinearizationKey,
 		return evk.RelinearizationKey, nil
 	}
 
-	return nil, fmt.Errorf("RelinearizationKey is nil")
+	return nil, fmt.Errorf("%w: RelinearizationKey is nil", ErrMissingRelinKey)
+}
+
+// Merge imports all the Galois keys and the RelinearizationKey of other into the receiver.
+// If a Galois key is already present for a given Galois element, or if both sets hold a
+// RelinearizationKey, the method returns an error, unless overwrite is set to true, in which
+// case the keys of other take precedence. The keys are shared, not copied.
+func (evk *MemEvaluationKeySet) Merge(other EvaluationKeySet, overwrite bool) (err error) {
+
+	if evk.GaloisKeys == nil {
+		evk.GaloisKeys = map[uint64]*GaloisKey{}
+	}
+
+	galEls := other.GetGaloisKeysList()
+
+	rk, rkErr := other.GetRelinearizationKey()
+
+	if !overwrite {
+
+		for _, galEl := range galEls {
+			if _, ok := evk.GaloisKeys[galEl]; ok {
+				return fmt.Errorf("GaloisKey[%d] is already present in the receiver", galEl)
+			}
+		}
+
+		if rkErr == nil && evk.RelinearizationKey != nil {
+			return fmt.Errorf("RelinearizationKey is already present in the receiver")
+		}
+	}
+
+	for _, galEl := range galEls {
+
+		var gk *GaloisKey
+		if gk, err = other.GetGaloisKey(galEl); err != nil {
+			return fmt.Errorf("other.GetGaloisKey(%d): %w", galEl, err)
+		}
+
+		evk.GaloisKeys[galEl] = gk
+	}
+
+	if rkErr == nil {
+		evk.RelinearizationKey = rk
+	}
+
+	return
 }
 
 func (evk MemEvaluationKeySet) BinarySize() (size int) {
@@ -789,3 +971,31 @@ func (evk *MemEvaluationKeySet) UnmarshalBinary(p []byte) (err error) {
 	_, err = evk.ReadFrom(buffer.NewBuffer(p))
 	return
 }
+
+// MarshalBinaryWithDigest encodes the object into a binary form on a newly allocated
+// slice of bytes and appends the SHA-256 digest of the encoding, enabling
+// [MemEvaluationKeySet.UnmarshalBinaryWithDigest] to detect corruption or tampering
+// of the key material. Note that the digest provides integrity, not authenticity:
+// an adversary able to modify the encoding can also recompute the digest.
+func (evk MemEvaluationKeySet) MarshalBinaryWithDigest() (p []byte, err error) {
+	if p, err = evk.MarshalBinary(); err != nil {
+		return
+	}
+	digest := sha256.Sum256(p)
+	return append(p, digest[:]...), nil
+}
+
+// UnmarshalBinaryWithDigest decodes a slice of bytes generated by
+// [MemEvaluationKeySet.MarshalBinaryWithDigest] on the object, after verifying
+// the appended SHA-256 digest. It returns an error if the digest does not match
+// the encoding.
+func (evk *MemEvaluationKeySet) UnmarshalBinaryWithDigest(p []byte) (err error) {
+	if len(p) < sha256.Size {
+		return fmt.Errorf("invalid encoding: smaller than a SHA-256 digest")
+	}
+	data, digest := p[:len(p)-sha256.Size], p[len(p)-sha256.Size:]
+	if sha256.Sum256(data) != [sha256.Size]byte(digest) {
+		return fmt.Errorf("invalid encoding: SHA-256 digest mismatch")
+	}
+	return evk.UnmarshalBinary(data)
+}
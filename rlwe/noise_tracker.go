@@ -0,0 +1,164 @@
+package rlwe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NoiseRecord stores the noise measurement of a single tracked operation.
+// Before and After are the log2 of the standard deviation of the decryption
+// of the input (resp. output) ciphertext, as returned by [Norm].
+type NoiseRecord struct {
+	Op     string
+	Before float64
+	After  float64
+}
+
+// Growth returns the number of bits added by the operation (After - Before).
+func (r NoiseRecord) Growth() float64 {
+	return r.After - r.Before
+}
+
+// NoiseOpStats aggregates the [NoiseRecord] of all the tracked calls of a
+// given operation. Min, Max and Mean are statistics over the per-call noise
+// growth in bits.
+type NoiseOpStats struct {
+	Op    string
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+}
+
+// NoiseTracker is a diagnostic decorator around an [Evaluator] that measures
+// the noise of the input and output ciphertexts of each tracked operation and
+// aggregates the measurements into a per-operation report.
+//
+// Since measuring the noise requires decrypting, the NoiseTracker must be
+// instantiated with a [Decryptor] holding the secret-key: it is intended for
+// tests, debugging and circuit tuning only, and must not be used in production.
+//
+// The reported statistic is the log2 of the standard deviation of the full
+// decryption (i.e. message + error, see [Norm]). To measure the noise in
+// isolation, track a circuit evaluated on encryptions of zero, for which the
+// decryption only contains the error.
+//
+// The embedded [Evaluator] is accessible for operations that do not need to be
+// tracked. The methods overridden by the NoiseTracker ([NoiseTracker.Automorphism],
+// [NoiseTracker.ApplyEvaluationKey] and [NoiseTracker.Relinearize]) record a
+// measurement on each call; any other operation can be tracked with
+// [NoiseTracker.Track].
+type NoiseTracker struct {
+	*Evaluator
+	dec     *Decryptor
+	records []NoiseRecord
+}
+
+// NewNoiseTracker instantiates a new [NoiseTracker] wrapping the provided
+// [Evaluator] and [Decryptor]. The [Decryptor] must hold the secret-key under
+// which the tracked ciphertexts are encrypted.
+func NewNoiseTracker(eval *Evaluator, dec *Decryptor) *NoiseTracker {
+	return &NoiseTracker{
+		Evaluator: eval,
+		dec:       dec,
+	}
+}
+
+// Noise returns the log2 of the standard deviation of the decryption of ct
+// (see [Norm]).
+func (nt *NoiseTracker) Noise(ct *Ciphertext) (std float64) {
+	std, _, _ = Norm(ct, nt.dec)
+	return
+}
+
+// Track measures the noise of ctIn, calls f and measures the noise of opOut,
+// recording the two measurements under the operation name op. If f returns an
+// error, no record is appended and the error is returned. ctIn and opOut can
+// point to the same ciphertext.
+func (nt *NoiseTracker) Track(op string, ctIn, opOut *Ciphertext, f func() error) (err error) {
+
+	before := nt.Noise(ctIn)
+
+	if err = f(); err != nil {
+		return
+	}
+
+	nt.records = append(nt.records, NoiseRecord{Op: op, Before: before, After: nt.Noise(opOut)})
+
+	return
+}
+
+// Automorphism wraps [Evaluator.Automorphism], recording the noise of ctIn and opOut.
+func (nt *NoiseTracker) Automorphism(ctIn *Ciphertext, galEl uint64, opOut *Ciphertext) (err error) {
+	return nt.Track("Automorphism", ctIn, opOut, func() error {
+		return nt.Evaluator.Automorphism(ctIn, galEl, opOut)
+	})
+}
+
+// ApplyEvaluationKey wraps [Evaluator.ApplyEvaluationKey], recording the noise of ctIn and opOut.
+func (nt *NoiseTracker) ApplyEvaluationKey(ctIn *Ciphertext, evk *EvaluationKey, opOut *Ciphertext) (err error) {
+	return nt.Track("ApplyEvaluationKey", ctIn, opOut, func() error {
+		return nt.Evaluator.ApplyEvaluationKey(ctIn, evk, opOut)
+	})
+}
+
+// Relinearize wraps [Evaluator.Relinearize], recording the noise of ctIn and opOut.
+func (nt *NoiseTracker) Relinearize(ctIn, opOut *Ciphertext) (err error) {
+	return nt.Track("Relinearize", ctIn, opOut, func() error {
+		return nt.Evaluator.Relinearize(ctIn, opOut)
+	})
+}
+
+// Records returns the individual measurements, in the order in which they were
+// recorded.
+func (nt *NoiseTracker) Records() []NoiseRecord {
+	return nt.records
+}
+
+// Reset discards all the recorded measurements.
+func (nt *NoiseTracker) Reset() {
+	nt.records = nil
+}
+
+// Report aggregates the recorded measurements into one [NoiseOpStats] per
+// operation name, in the order in which each operation was first recorded.
+func (nt *NoiseTracker) Report() (report []NoiseOpStats) {
+
+	idx := map[string]int{}
+
+	for _, r := range nt.records {
+
+		i, ok := idx[r.Op]
+
+		if !ok {
+			i = len(report)
+			idx[r.Op] = i
+			report = append(report, NoiseOpStats{Op: r.Op, Min: r.Growth(), Max: r.Growth()})
+		}
+
+		growth := r.Growth()
+
+		report[i].Count++
+		report[i].Min = min(report[i].Min, growth)
+		report[i].Max = max(report[i].Max, growth)
+		report[i].Mean += growth
+	}
+
+	for i := range report {
+		report[i].Mean /= float64(report[i].Count)
+	}
+
+	return
+}
+
+// String returns a human readable summary of the report, one operation per line.
+func (nt *NoiseTracker) String() string {
+
+	var sb strings.Builder
+
+	for _, stats := range nt.Report() {
+		fmt.Fprintf(&sb, "%s: count=%d growth(bits): min=%.2f max=%.2f mean=%.2f\n", stats.Op, stats.Count, stats.Min, stats.Max, stats.Mean)
+	}
+
+	return sb.String()
+}
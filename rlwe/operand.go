@@ -1,5 +1,11 @@
 package rlwe
 
+import (
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/utils/bignum"
+)
+
 // Operand is an empty interface aimed at
 // providing an anchor for documentation.
 //
@@ -10,3 +16,53 @@ package rlwe
 // evaluators.
 type Operand interface {
 }
+
+// OperandKind labels the class of an [Operand], as interpreted by the type
+// switches of the homomorphic evaluators.
+type OperandKind int
+
+const (
+	// OperandUnknown labels operands of a type that the evaluators do not accept.
+	OperandUnknown OperandKind = iota
+	// OperandElement labels operands implementing the [Element] interface,
+	// i.e. ciphertexts and plaintexts.
+	OperandElement
+	// OperandScalar labels operands holding a single scalar value.
+	OperandScalar
+	// OperandVector labels operands holding a slice of scalar values.
+	OperandVector
+)
+
+// String implements the [fmt.Stringer] interface.
+func (kind OperandKind) String() string {
+	switch kind {
+	case OperandElement:
+		return "Element"
+	case OperandScalar:
+		return "Scalar"
+	case OperandVector:
+		return "Vector"
+	default:
+		return "Unknown"
+	}
+}
+
+// ClassifyOperand returns the [OperandKind] of op, i.e. which class of the
+// evaluators' type switches op falls into. It is the union of the types accepted
+// by the evaluators of the he packages, enabling downstream code to branch once
+// on the kind and new methods to remain consistent in the types they accept.
+func ClassifyOperand(op Operand) OperandKind {
+	switch op.(type) {
+	case Element:
+		return OperandElement
+	case complex128, float64, int, int64, uint, uint64,
+		big.Int, big.Float, bignum.Complex,
+		*big.Int, *big.Float, *bignum.Complex:
+		return OperandScalar
+	case []complex128, []float64, []int64, []uint64,
+		[]big.Float, []bignum.Complex:
+		return OperandVector
+	default:
+		return OperandUnknown
+	}
+}
@@ -97,7 +97,7 @@ func (pt *Plaintext) Randomize(params ParameterProvider, source *sampling.Source
 
 // BinarySize returns the serialized size of the object in bytes.
 func (pt Plaintext) BinarySize() (size int) {
-	size++
+	size = codecHeaderSize + 1
 	if pt.MetaData != nil {
 		size += pt.MetaData.BinarySize()
 	}
@@ -121,6 +121,12 @@ func (pt Plaintext) WriteTo(w io.Writer) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = writeCodecHeader(w); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		if pt.MetaData != nil {
 
 			if inc, err = buffer.WriteUint8(w, 1); err != nil {
@@ -170,6 +176,12 @@ func (pt *Plaintext) ReadFrom(r io.Reader) (n int64, err error) {
 
 		var inc int64
 
+		if inc, err = readCodecHeader(r); err != nil {
+			return n + inc, err
+		}
+
+		n += inc
+
 		var hasMetaData uint8
 
 		if inc, err = buffer.ReadUint8(r, &hasMetaData); err != nil {
@@ -1,11 +1,15 @@
 package rlwe
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"math"
+	"math/big"
+	"math/bits"
 	"runtime"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -64,6 +68,10 @@ func TestRLWE(t *testing.T) {
 				testKeyGenerator(tc, paramsLit.DigitDecomposition, t)
 				testMarshaller(tc, t)
 				testWriteAndRead(tc, paramsLit.DigitDecomposition, t)
+				testEvaluationKeySetMerge(tc, t)
+				testEvaluationKeySetIncremental(tc, t)
+				testSentinelErrors(tc, t)
+				testClassifyOperand(tc, t)
 
 				var LevelQ []int
 				if params.MaxLevelQ() > 0 {
@@ -76,6 +84,7 @@ func TestRLWE(t *testing.T) {
 
 					for _, testSet := range []func(tc *TestContext, level int, dd DigitDecomposition, t *testing.T){
 						testEncryptor,
+						testModUpToLevel,
 						testGadgetProduct,
 						testApplyEvaluationKey,
 						testAutomorphism,
@@ -91,6 +100,8 @@ func TestRLWE(t *testing.T) {
 
 	testUserDefinedParameters(t)
 	testCoalescedGadgetProduct(t)
+	testDigitDecompositionNoise(t)
+	testTestRandomness(t)
 }
 
 type TestContext struct {
@@ -105,6 +116,19 @@ type TestContext struct {
 
 func testUserDefinedParameters(t *testing.T) {
 
+	t.Run("Parameters/Preset", func(t *testing.T) {
+
+		for _, name := range []string{"LogN12LogQP109", "LogN13LogQP218", "LogN14LogQP438", "LogN15LogQP880", "LogN16LogQP1761"} {
+			p, ok := Preset(name)
+			require.True(t, ok)
+			_, err := NewParametersFromLiteral(p)
+			require.NoError(t, err)
+		}
+
+		_, ok := Preset("LogN17LogQP3522")
+		require.False(t, ok)
+	})
+
 	t.Run("Parameters/QWithLogP", func(t *testing.T) {
 		params, err := NewParametersFromLiteral(ParametersLiteral{
 			LogN: 4,
@@ -236,6 +260,22 @@ func testParameters(tc *TestContext, t *testing.T) {
 			require.Equal(t, uint64(1), res)
 		}
 	})
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), DigitDecomposition{}, "SecurityEstimate"), func(t *testing.T) {
+
+		lwe := params.SecurityEstimate()
+
+		require.Equal(t, params.N(), lwe.N)
+		require.Equal(t, params.QPBigInt(), lwe.Q)
+		require.Equal(t, params.LogQP(), lwe.LogQ)
+		require.True(t, params.Xs().Equal(lwe.Xs))
+		require.True(t, params.Xe().Equal(lwe.Xe))
+
+		snippet := lwe.String()
+		require.Contains(t, snippet, fmt.Sprintf("n=%d", params.N()))
+		require.Contains(t, snippet, fmt.Sprintf("q=%s", params.QPBigInt().String()))
+		require.Contains(t, snippet, "LWE.estimate(params)")
+	})
 }
 
 func testKeyGenerator(tc *TestContext, dd DigitDecomposition, t *testing.T) {
@@ -245,6 +285,23 @@ func testKeyGenerator(tc *TestContext, dd DigitDecomposition, t *testing.T) {
 	sk := tc.sk
 	pk := tc.pk
 
+	// Checks that two seeded KeyGenerators with the same seed produce identical keys
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), dd, "KeyGenerator/WithSeed"), func(t *testing.T) {
+
+		seed := [32]byte{0x01}
+
+		kgen0 := NewKeyGeneratorWithSeed(params, seed)
+		kgen1 := NewKeyGeneratorWithSeed(params, seed)
+
+		sk0 := kgen0.GenSecretKeyNew()
+		sk1 := kgen1.GenSecretKeyNew()
+
+		require.True(t, sk0.Equal(sk1))
+
+		galEl := params.GaloisElement(1)
+		require.True(t, kgen0.GenGaloisKeyNew(galEl, sk0).Equal(kgen1.GenGaloisKeyNew(galEl, sk1)))
+	})
+
 	// Checks that the secret-key has exactly params.h non-zero coefficients
 	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), dd, "KeyGenerator/GenSecretKey"), func(t *testing.T) {
 
@@ -284,6 +341,33 @@ func testKeyGenerator(tc *TestContext, dd DigitDecomposition, t *testing.T) {
 		}
 	})
 
+	// Checks that the sparse secret-key has exactly h non-zero coefficients
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), dd, "KeyGenerator/GenSparseSecretKey"), func(t *testing.T) {
+
+		h := params.N() >> 4
+
+		skSparse, err := kgen.GenSparseSecretKeyNew(h)
+		require.NoError(t, err)
+
+		skINTT := NewSecretKey(params)
+		params.RingQ().AtLevel(skSparse.LevelQ()).INTT(skSparse.Q, skINTT.Q)
+		for i := range skINTT.Q {
+			var zeros int
+			for j := range skINTT.Q.At(i) {
+				if skINTT.Q.At(i)[j] == 0 {
+					zeros++
+				}
+			}
+			require.Equal(t, params.N(), zeros+h)
+		}
+
+		_, err = kgen.GenSparseSecretKeyNew(0)
+		require.Error(t, err)
+
+		_, err = kgen.GenSparseSecretKeyNew(params.N() + 1)
+		require.Error(t, err)
+	})
+
 	// Checks that sum([-as + e, a] + [as])) <= N * 6 * sigma
 	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), dd, "KeyGenerator/GenPublicKey"), func(t *testing.T) {
 		require.GreaterOrEqual(t, math.Log2(params.NoiseFreshSK())+1, NoisePublicKey(pk, sk, params))
@@ -428,6 +512,73 @@ func testEncryptor(tc *TestContext, level int, dd DigitDecomposition, t *testing
 		require.GreaterOrEqual(t, math.Log2(params.NoiseFreshSK())+1, ringQ.Stats(pt.Q)[0])
 	})
 
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Ciphertext/CloneAtLevel"), func(t *testing.T) {
+
+		ct := NewCiphertext(params, 1, params.MaxLevel(), -1)
+		require.NoError(t, enc.EncryptZero(ct))
+
+		clone := ct.CloneAtLevel(level)
+		require.Equal(t, level, clone.Level())
+		require.True(t, clone.MetaData.Equal(ct.MetaData))
+
+		ref := ct.Clone()
+		ref.ResizeQ(level)
+		require.True(t, clone.Equal(ref))
+
+		// The clone does not share its backing array with the receiver
+		clone.Q[0].At(0)[0]++
+		require.False(t, clone.Equal(ref))
+
+		require.Panics(t, func() { ct.CloneAtLevel(ct.Level() + 1) })
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Decryptor/DecryptToShares"), func(t *testing.T) {
+		ringQ := params.RingQ().AtLevel(level)
+
+		nParties := 3
+		eFlood := DefaultNoise
+
+		pt := NewPlaintext(params, level, -1)
+		ct := NewCiphertext(params, 1, level, -1)
+
+		enc.Encrypt(pt, ct)
+
+		shares := dec.DecryptToShares(ct, nParties, eFlood)
+		require.Equal(t, nParties, len(shares))
+
+		// Recombines the shares
+		for i := 1; i < nParties; i++ {
+			ringQ.Add(shares[0].Q, shares[i].Q, shares[0].Q)
+		}
+
+		if shares[0].IsNTT {
+			ringQ.INTT(shares[0].Q, shares[0].Q)
+		}
+
+		noise := params.NoiseFreshSK()*params.NoiseFreshSK() + float64(nParties)*eFlood*eFlood
+
+		require.GreaterOrEqual(t, math.Log2(math.Sqrt(noise))+1, ringQ.Stats(shares[0].Q)[0])
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Decryptor/DecryptChecked"), func(t *testing.T) {
+		ringQ := params.RingQ().AtLevel(level)
+
+		pt := NewPlaintext(params, level, -1)
+		ct := NewCiphertext(params, 1, level, -1)
+
+		enc.Encrypt(pt, ct)
+
+		// A fresh encryption of zero decrypts to the fresh noise,
+		// which lies well below the noise bound of the parameters
+		require.NoError(t, dec.DecryptChecked(ct, pt, params.NoiseBound()))
+
+		// Simulates a noise overflow by adding uniform garbage to the ciphertext,
+		// making its decryption uniform in [-Q/2, Q/2]
+		ring.NewUniformSampler(sampling.NewSource([32]byte{}), ringQ.ModuliChain()).AtLevel(level).ReadAndAdd(ct.Q[0])
+
+		require.Error(t, dec.DecryptChecked(ct, pt, params.NoiseBound()))
+	})
+
 	t.Run(testString(params, level, params.MaxLevelP(), dd, "Encryptor/Encrypt/Sk/WithSource"), func(t *testing.T) {
 
 		seedXe := [32]byte{0x01}
@@ -446,6 +597,55 @@ func testEncryptor(tc *TestContext, level int, dd DigitDecomposition, t *testing
 		require.False(t, ct0.Equal(ct1))
 	})
 
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Encryptor/EncryptMany/Sk"), func(t *testing.T) {
+
+		ringQ := params.RingQ().AtLevel(level)
+
+		pts := make([]*Plaintext, 4)
+		cts := make([]*Ciphertext, 4)
+		for i := range cts {
+			pts[i] = NewPlaintext(params, level, -1)
+			cts[i] = NewCiphertext(params, 1, level, -1)
+		}
+
+		// A nil plaintext produces an encryption of zero
+		pts[3] = nil
+
+		require.NoError(t, enc.EncryptMany(pts, cts))
+
+		pt := NewPlaintext(params, level, -1)
+		for i := range cts {
+			dec.Decrypt(cts[i], pt)
+
+			if pt.IsNTT {
+				ringQ.INTT(pt.Q, pt.Q)
+			}
+
+			require.GreaterOrEqual(t, math.Log2(params.NoiseFreshSK())+1, ringQ.Stats(pt.Q)[0])
+		}
+
+		// Mismatched lengths
+		require.Error(t, enc.EncryptMany(pts[:2], cts))
+
+		seedXe := [32]byte{0x01}
+		seedXa := [32]byte{0x02}
+
+		// The batch is fully deterministic given the seeds
+		cts0 := make([]*Ciphertext, 4)
+		cts1 := make([]*Ciphertext, 4)
+		for i := range cts0 {
+			cts0[i] = NewCiphertext(params, 1, level, -1)
+			cts1[i] = NewCiphertext(params, 1, level, -1)
+		}
+
+		require.NoError(t, enc.WithSeededPublicRandomness(seedXa).WithSeededSecretRandomness(seedXe).EncryptMany(pts, cts0))
+		require.NoError(t, enc.WithSeededPublicRandomness(seedXa).WithSeededSecretRandomness(seedXe).EncryptMany(pts, cts1))
+
+		for i := range cts0 {
+			require.True(t, cts0[i].Equal(cts1[i]))
+		}
+	})
+
 	t.Run(testString(params, level, params.MaxLevelP(), dd, "Encrypt/Sk/ShallowCopy"), func(t *testing.T) {
 		skEnc1 := NewEncryptor(params, sk)
 		skEnc2 := skEnc1.ShallowCopy()
@@ -479,6 +679,163 @@ func testEncryptor(tc *TestContext, level int, dd DigitDecomposition, t *testing
 	})
 }
 
+func testModUpToLevel(tc *TestContext, level int, dd DigitDecomposition, t *testing.T) {
+
+	params := tc.params
+	eval := tc.eval
+	enc := tc.enc
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/ModUpToLevel"), func(t *testing.T) {
+
+		ct := NewCiphertext(params, 1, level, -1)
+		require.NoError(t, enc.EncryptZero(ct))
+
+		// The target level must be strictly larger than the current level and at most MaxLevel
+		require.Error(t, eval.ModUpToLevel(ct, level))
+		require.Error(t, eval.ModUpToLevel(ct, params.MaxLevel()+1))
+
+		if level == params.MaxLevel() {
+			t.Skip("Skipping: Level = MaxLevel")
+		}
+
+		rQ := params.RingQ().AtLevel(level)
+
+		N := params.N()
+
+		// Centered coefficients of the ciphertext before the basis extension
+		want := make([][]big.Int, ct.Degree()+1)
+		for i := range want {
+			want[i] = make([]big.Int, N)
+			buff := *ct.Q[i].Clone()
+			if ct.IsNTT {
+				rQ.INTT(buff, buff)
+			}
+			rQ.PolyToBigintCentered(buff, 1, want[i])
+		}
+
+		targetLevel := params.MaxLevel()
+
+		require.NoError(t, eval.ModUpToLevel(ct, targetLevel))
+		require.Equal(t, targetLevel, ct.Level())
+
+		rQExt := params.RingQ().AtLevel(targetLevel)
+
+		// The extension must be exact: the centered coefficients are left unchanged
+		have := make([]big.Int, N)
+		for i := range want {
+			buff := *ct.Q[i].Clone()
+			if ct.IsNTT {
+				rQExt.INTT(buff, buff)
+			}
+			rQExt.PolyToBigintCentered(buff, 1, have)
+			for j := range have {
+				require.Equal(t, 0, want[i][j].Cmp(&have[j]))
+			}
+		}
+	})
+}
+
+func testDigitDecompositionNoise(t *testing.T) {
+
+	t.Run("Evaluator/GadgetProduct/DigitDecompositionNoise", func(t *testing.T) {
+
+		params, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    10,
+			LogQ:    []int{50},
+			NTTFlag: true,
+		})
+
+		require.NoError(t, err)
+
+		LevelQ := params.MaxLevelQ()
+
+		kgen := NewKeyGenerator(params)
+		sk := kgen.GenSecretKeyNew()
+		skOut := kgen.GenSecretKeyNew()
+		eval := NewEvaluator(params, nil)
+
+		rQ := params.RingQ().AtLevel(LevelQ)
+
+		a := ring.NewUniformSampler(sampling.NewSource([32]byte{'a', 'b', 'c'}), rQ.ModuliChain()).ReadNew(params.N())
+
+		// Returns the log2 of the standard deviation of the gadget-product
+		// noise for the given digit decomposition type.
+		noise := func(ddType DigitDecompositionType) float64 {
+
+			evkParams := EvaluationKeyParameters{DigitDecomposition: DigitDecomposition{Type: ddType, Log2Basis: 16}}
+
+			evk := kgen.GenEvaluationKeyNew(sk, skOut, evkParams)
+
+			ct := NewCiphertext(params, 1, LevelQ, -1)
+
+			eval.GadgetProduct(LevelQ, a, ct.IsNTT, &evk.GadgetCiphertext, ct)
+
+			pt := NewDecryptor(params, skOut).DecryptNew(ct)
+
+			rQ.MulCoeffsMontgomeryThenSub(a, sk.Q, pt.Q)
+			rQ.INTT(pt.Q, pt.Q)
+
+			return rQ.Stats(pt.Q)[0]
+		}
+
+		NoiseBound := float64(params.LogN() + 16)
+
+		unsigned := noise(Unsigned)
+		signed := noise(Signed)
+		balanced := noise(SignedBalanced)
+
+		require.GreaterOrEqual(t, NoiseBound, unsigned)
+		require.GreaterOrEqual(t, NoiseBound, signed)
+		require.GreaterOrEqual(t, NoiseBound, balanced)
+
+		// The unsigned digits have mean 2^{w-1}, which costs about one bit
+		// of noise over the signed decompositions (E[X^2] is 4x larger).
+		require.Greater(t, unsigned, signed)
+		require.Greater(t, unsigned, balanced)
+
+		// The signed and balanced decompositions have (nearly) the same
+		// second moment: their noise must agree within a fraction of a bit,
+		// the balanced one having the smallest mean (E[X] = 0).
+		require.InDelta(t, signed, balanced, 1)
+	})
+}
+
+func testTestRandomness(t *testing.T) {
+
+	t.Run("TestRandomness", func(t *testing.T) {
+
+		params, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:    10,
+			LogQ:    []int{50},
+			LogP:    []int{60},
+			NTTFlag: true,
+		})
+
+		require.NoError(t, err)
+
+		sample := func(seed [32]byte) (sk *SecretKey, ct *Ciphertext) {
+			SetTestRandomness(seed)
+			defer UnsetTestRandomness()
+			sk = NewKeyGenerator(params).GenSecretKeyNew()
+			ct = NewCiphertext(params, 1, params.MaxLevelQ(), -1)
+			require.NoError(t, NewEncryptor(params, sk).EncryptZero(ct))
+			return
+		}
+
+		sk0, ct0 := sample([32]byte{0x01})
+		sk1, ct1 := sample([32]byte{0x01})
+		sk2, ct2 := sample([32]byte{0x02})
+
+		// Identical seeds produce identical keys and encryptions
+		require.True(t, sk0.Equal(sk1))
+		require.True(t, ct0.Equal(ct1))
+
+		// Distinct seeds do not
+		require.False(t, sk0.Equal(sk2))
+		require.False(t, ct0.Equal(ct2))
+	})
+}
+
 func testCoalescedGadgetProduct(t *testing.T) {
 
 	var params Parameters
@@ -536,6 +893,52 @@ func testCoalescedGadgetProduct(t *testing.T) {
 
 		require.GreaterOrEqual(t, NoiseBound, rQ.Stats(pt.Q)[0])
 	})
+
+	t.Run(testString(params, LevelQ, params.MaxLevelP(), DigitDecomposition{}, "Evaluator/GadgetProductCoalesced"), func(t *testing.T) {
+
+		sk0 := kgen.GenSecretKeyNew()
+		sk1 := kgen.GenSecretKeyNew()
+		skOut := kgen.GenSecretKeyNew()
+
+		// Generates a random polynomial
+		a := sampler.ReadNew(params.N())
+
+		// Generate the receivers
+		cts := []*Ciphertext{
+			NewCiphertext(params, 1, LevelQ, -1),
+			NewCiphertext(params, 1, LevelQ, -1),
+		}
+
+		// Generate the evaluation keys [-bskOut + si, b]
+		evk0 := kgen.GenEvaluationKeyNew(sk0, skOut)
+		evk1 := kgen.GenEvaluationKeyNew(sk1, skOut)
+
+		buf := eval.NewHoistingBuffer(LevelQ, params.MaxLevelP())
+
+		// Gadget products sharing the decomposition of a: cts[i] = [-cskOut + asi, c]
+		require.NoError(t, eval.GadgetProductCoalesced(LevelQ, a, cts[0].IsNTT, buf, []*GadgetCiphertext{&evk0.GadgetCiphertext, &evk1.GadgetCiphertext}, cts))
+
+		dec := NewDecryptor(params, skOut)
+
+		for i, sk := range []*SecretKey{sk0, sk1} {
+
+			// pt = asi
+			pt := dec.DecryptNew(cts[i])
+
+			// pt = asi - asi = 0 (+ some noise)
+			if !pt.IsNTT {
+				rQ.NTT(pt.Q, pt.Q)
+			}
+
+			rQ.MulCoeffsMontgomeryThenSub(a, sk.Q, pt.Q)
+			rQ.INTT(pt.Q, pt.Q)
+
+			require.GreaterOrEqual(t, NoiseBound, rQ.Stats(pt.Q)[0])
+		}
+
+		// Mismatched lengths are rejected
+		require.Error(t, eval.GadgetProductCoalesced(LevelQ, a, true, buf, []*GadgetCiphertext{&evk0.GadgetCiphertext}, cts))
+	})
 }
 
 func testGadgetProduct(tc *TestContext, LevelQ int, dd DigitDecomposition, t *testing.T) {
@@ -600,6 +1003,59 @@ func testGadgetProduct(tc *TestContext, LevelQ int, dd DigitDecomposition, t *te
 			require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
 		})
 
+		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/GadgetProductNoP"), func(t *testing.T) {
+
+			skOut := kgen.GenSecretKeyNew()
+
+			// Generates a random polynomial
+			a := sampler.ReadNew(params.N())
+
+			// Generate the receiver
+			ct := NewCiphertext(params, 1, LevelQ, -1)
+
+			// A key generated with an auxiliary prime must be rejected
+			if LevelP > -1 {
+				evkP := NewEvaluationKey(params, evkParams)
+				kgen.GenEvaluationKey(sk, skOut, evkP)
+				require.Error(t, eval.GadgetProductNoP(LevelQ, a, ct.IsNTT, &evkP.GadgetCiphertext, ct))
+			}
+
+			evk := NewEvaluationKey(params, EvaluationKeyParameters{LevelQ: utils.Pointy(LevelQ), LevelP: utils.Pointy(-1), DigitDecomposition: dd})
+
+			// Generate the evaluationkey [-bs1 + s1, b]
+			kgen.GenEvaluationKey(sk, skOut, evk)
+
+			// Gadget product without P: ct = [-cs1 + as0 , c]
+			require.NoError(t, eval.GadgetProductNoP(LevelQ, a, ct.IsNTT, &evk.GadgetCiphertext, ct))
+
+			// pt = as0
+			pt := NewDecryptor(params, skOut).DecryptNew(ct)
+
+			rQ := params.RingQ().AtLevel(LevelQ)
+
+			// pt = as1 - as1 = 0 (+ some noise)
+			if !pt.IsNTT {
+				rQ.NTT(pt.Q, pt.Q)
+				rQ.NTT(a, a)
+			}
+
+			rQ.MulCoeffsMontgomeryThenSub(a, sk.Q, pt.Q)
+			rQ.INTT(pt.Q, pt.Q)
+
+			// Without P the noise of the product is scaled by the digit basis:
+			// the largest q_i for the pure RNS gadget, 2^{Log2Basis} otherwise.
+			NoiseBoundNoP := NoiseBound
+			if dd.Type == 0 {
+				var maxLogQi int
+				for _, qi := range params.Q()[:LevelQ+1] {
+					maxLogQi = max(maxLogQi, bits.Len64(qi))
+				}
+				NoiseBoundNoP = float64(params.LogN() + maxLogQi)
+			}
+
+			require.GreaterOrEqual(t, NoiseBoundNoP, rQ.Stats(pt.Q)[0])
+		})
+
 		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/GadgetProductHoisted"), func(t *testing.T) {
 
 			if dd.Type != 0 {
@@ -687,36 +1143,152 @@ func testApplyEvaluationKey(tc *TestContext, level int, dd DigitDecomposition, t
 		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
 	})
 
-	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/ApplyEvaluationKey/LargeToSmall"), func(t *testing.T) {
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/KeySwitchNew"), func(t *testing.T) {
 
-		paramsLargeDim := params
+		// Party B generates its own secret
+		skB := kgen.GenSecretKeyNew()
 
-		paramsSmallDim, err := NewParametersFromLiteral(ParametersLiteral{
-			LogN:     paramsLargeDim.LogN() - 1,
-			Q:        paramsLargeDim.Q(),
-			P:        []uint64{0x1ffffffff6c80001, 0x1ffffffff6140001}[:paramsLargeDim.PCount()], // some other P to test that the modulus is correctly extended in the keygen
-			RingType: paramsLargeDim.RingType(),
-		})
+		// Party A (holding sk) generates a switching key re-encrypting
+		// its ciphertexts to party B
+		swk := kgen.GenEvaluationKeyNew(sk, skB, evkParams)
 
-		require.Nil(t, err)
+		pt := NewPlaintext(params, level, -1)
 
-		kgenLargeDim := kgen
-		skLargeDim := sk
-		kgenSmallDim := NewKeyGenerator(paramsSmallDim)
-		skSmallDim := kgenSmallDim.GenSecretKeyNew()
+		ct := NewCiphertext(params, 1, level, -1)
 
-		evk := kgenLargeDim.GenEvaluationKeyNew(skLargeDim, skSmallDim, evkParams)
+		enc.Encrypt(pt, ct)
 
-		enc := NewEncryptor(paramsLargeDim, skLargeDim)
+		// The proxy publicly switches the ciphertext of A to B
+		res, err := eval.KeySwitchNew(ct, swk)
+		require.NoError(t, err)
 
-		ctLargeDim := NewCiphertext(paramsLargeDim, 1, level, -1)
-		require.NoError(t, enc.EncryptZero(ctLargeDim))
-		ctSmallDim := NewCiphertext(paramsSmallDim, 1, level, -1)
+		// Test that Dec(KS(Enc(pt, sk), skB), skB) has a small norm
+		NewDecryptor(params, skB).Decrypt(res, pt)
 
-		// skLarge -> skSmall embeded in N
-		eval.ApplyEvaluationKey(ctLargeDim, evk, ctSmallDim)
+		ringQ := params.RingQ().AtLevel(level)
 
-		// Decrypts with smaller dimension key
+		if pt.IsNTT {
+			ringQ.INTT(pt.Q, pt.Q)
+		}
+
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+
+		// The input ciphertext must be of degree 1
+		_, err = eval.KeySwitchNew(NewCiphertext(params, 2, level, -1), swk)
+		require.Error(t, err)
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/NoiseTracker"), func(t *testing.T) {
+
+		// Key-switches sk -> sk so that a single decryptor can measure
+		// both the input and the output of the tracked operation
+		evk := kgen.GenEvaluationKeyNew(sk, sk, evkParams)
+
+		// Encrypts zero, so that the measurements only contain the noise
+		pt := NewPlaintext(params, level, -1)
+
+		ct := NewCiphertext(params, 1, level, -1)
+
+		enc.Encrypt(pt, ct)
+
+		nt := NewNoiseTracker(eval, dec)
+
+		res := NewCiphertext(params, 1, level, -1)
+
+		require.NoError(t, nt.ApplyEvaluationKey(ct, evk, res))
+		require.NoError(t, nt.ApplyEvaluationKey(ct, evk, res))
+
+		// Tracks an operation that is not decorated by the NoiseTracker
+		require.NoError(t, nt.Track("ApplyEvaluationKey/WithPool", ct, res, func() error {
+			evalPool := nt.Evaluator.WithPool(ring.NewRNSPolyPool()).ShallowCopy()
+			defer evalPool.Release()
+			return evalPool.ApplyEvaluationKey(ct, evk, res)
+		}))
+
+		records := nt.Records()
+		require.Equal(t, 3, len(records))
+
+		// The key-switch must have added noise, and the output must still decrypt correctly
+		for _, r := range records {
+			require.Greater(t, r.After, r.Before)
+			require.Greater(t, r.Growth(), 0.0)
+			require.GreaterOrEqual(t, NoiseBound, r.After)
+		}
+
+		report := nt.Report()
+		require.Equal(t, 2, len(report))
+		require.Equal(t, "ApplyEvaluationKey", report[0].Op)
+		require.Equal(t, 2, report[0].Count)
+		require.Equal(t, "ApplyEvaluationKey/WithPool", report[1].Op)
+		require.Equal(t, 1, report[1].Count)
+		require.GreaterOrEqual(t, report[0].Max, report[0].Mean)
+		require.GreaterOrEqual(t, report[0].Mean, report[0].Min)
+
+		nt.Reset()
+		require.Equal(t, 0, len(nt.Records()))
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/ApplyEvaluationKey/WithPool"), func(t *testing.T) {
+
+		skOut := kgen.GenSecretKeyNew()
+
+		pt := NewPlaintext(params, level, -1)
+
+		ct := NewCiphertext(params, 1, level, -1)
+
+		enc.Encrypt(pt, ct)
+
+		// Draws the buffers of the evaluator (and of its shallow copies) from a pool
+		pool := ring.NewRNSPolyPool()
+
+		evalPool := eval.WithPool(pool).ShallowCopy()
+
+		evalPool.ApplyEvaluationKey(ct, kgen.GenEvaluationKeyNew(sk, skOut, evkParams), ct)
+
+		// Returns the buffers to the pool
+		evalPool.Release()
+
+		NewDecryptor(params, skOut).Decrypt(ct, pt)
+
+		ringQ := params.RingQ().AtLevel(level)
+
+		if pt.IsNTT {
+			ringQ.INTT(pt.Q, pt.Q)
+		}
+
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/ApplyEvaluationKey/LargeToSmall"), func(t *testing.T) {
+
+		paramsLargeDim := params
+
+		paramsSmallDim, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN:     paramsLargeDim.LogN() - 1,
+			Q:        paramsLargeDim.Q(),
+			P:        []uint64{0x1ffffffff6c80001, 0x1ffffffff6140001}[:paramsLargeDim.PCount()], // some other P to test that the modulus is correctly extended in the keygen
+			RingType: paramsLargeDim.RingType(),
+		})
+
+		require.Nil(t, err)
+
+		kgenLargeDim := kgen
+		skLargeDim := sk
+		kgenSmallDim := NewKeyGenerator(paramsSmallDim)
+		skSmallDim := kgenSmallDim.GenSecretKeyNew()
+
+		evk := kgenLargeDim.GenEvaluationKeyNew(skLargeDim, skSmallDim, evkParams)
+
+		enc := NewEncryptor(paramsLargeDim, skLargeDim)
+
+		ctLargeDim := NewCiphertext(paramsLargeDim, 1, level, -1)
+		require.NoError(t, enc.EncryptZero(ctLargeDim))
+		ctSmallDim := NewCiphertext(paramsSmallDim, 1, level, -1)
+
+		// skLarge -> skSmall embeded in N
+		eval.ApplyEvaluationKey(ctLargeDim, evk, ctSmallDim)
+
+		// Decrypts with smaller dimension key
 		dec := NewDecryptor(paramsSmallDim, skSmallDim)
 
 		ptSmallDim := dec.DecryptNew(ctSmallDim)
@@ -767,6 +1339,98 @@ func testApplyEvaluationKey(tc *TestContext, level int, dd DigitDecomposition, t
 
 		require.GreaterOrEqual(t, NoiseBound, ringQLargeDim.Stats(ptLargeDim.Q)[0])
 	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/RelinearizeDegree"), func(t *testing.T) {
+
+		rlks := kgen.GenRelinearizationKeyDegree(sk, 3, evkParams)
+
+		ringQ := params.RingQ().AtLevel(level)
+
+		sampler := ring.NewUniformSampler(sampling.NewSource(sampling.NewSeed()), ringQ.ModuliChain())
+
+		// Manually assembles a noiseless degree-3 encryption of zero
+		// under the key (1, sk, sk^2, sk^3), in the NTT domain.
+		ct := NewCiphertext(params, 3, level, -1)
+		ct.IsNTT = true
+
+		for i := 1; i < 4; i++ {
+			sampler.Read(ct.Q[i])
+		}
+
+		acc := ringQ.NewRNSPoly()
+		pow := ringQ.NewRNSPoly()
+
+		ringQ.MulCoeffsMontgomery(ct.Q[1], sk.Q, acc)
+		ringQ.MulCoeffsMontgomery(sk.Q, sk.Q, pow)
+		ringQ.MulCoeffsMontgomeryThenAdd(ct.Q[2], pow, acc)
+		ringQ.MulCoeffsMontgomery(pow, sk.Q, pow)
+		ringQ.MulCoeffsMontgomeryThenAdd(ct.Q[3], pow, acc)
+		ringQ.Neg(acc, ct.Q[0])
+
+		// Not enough relinearization keys for the degree
+		require.Error(t, eval.RelinearizeDegree(ct, rlks[:1], ct))
+
+		require.NoError(t, eval.RelinearizeDegree(ct, rlks, ct))
+		require.Equal(t, 1, ct.Degree())
+
+		// Degree-1 inputs are rejected
+		require.Error(t, eval.RelinearizeDegree(ct, rlks, ct))
+
+		pt := NewPlaintext(params, level, -1)
+
+		dec.Decrypt(ct, pt)
+
+		if pt.IsNTT {
+			ringQ.INTT(pt.Q, pt.Q)
+		}
+
+		// Two gadget products, each contributing NoiseBound bits of noise
+		require.GreaterOrEqual(t, NoiseBound+1, ringQ.Stats(pt.Q)[0])
+	})
+
+	t.Run(testString(params, level, params.MaxLevelP(), dd, "Evaluator/RelinearizeFromSerialized"), func(t *testing.T) {
+
+		eval := eval.WithKey(NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk, evkParams)))
+
+		ringQ := params.RingQ().AtLevel(level)
+
+		sampler := ring.NewUniformSampler(sampling.NewSource(sampling.NewSeed()), ringQ.ModuliChain())
+
+		// Manually assembles a noiseless degree-2 encryption of zero
+		// under the key (1, sk, sk^2), in the NTT domain.
+		ct := NewCiphertext(params, 2, level, -1)
+		ct.IsNTT = true
+
+		sampler.Read(ct.Q[1])
+		sampler.Read(ct.Q[2])
+
+		acc := ringQ.NewRNSPoly()
+		pow := ringQ.NewRNSPoly()
+
+		ringQ.MulCoeffsMontgomery(ct.Q[1], sk.Q, acc)
+		ringQ.MulCoeffsMontgomery(sk.Q, sk.Q, pow)
+		ringQ.MulCoeffsMontgomeryThenAdd(ct.Q[2], pow, acc)
+		ringQ.Neg(acc, ct.Q[0])
+
+		// The degree-2 state is shipped serialized and relinearized on arrival
+		data, err := ct.MarshalBinary()
+		require.NoError(t, err)
+
+		opOut := NewCiphertext(params, 1, level, -1)
+		require.NoError(t, eval.RelinearizeFromSerialized(data, opOut))
+		require.Equal(t, 1, opOut.Degree())
+
+		pt := dec.DecryptNew(opOut)
+
+		if pt.IsNTT {
+			ringQ.INTT(pt.Q, pt.Q)
+		}
+
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+
+		// Truncated data must be rejected
+		require.Error(t, eval.RelinearizeFromSerialized(data[:len(data)-1], opOut))
+	})
 }
 
 func testAutomorphism(tc *TestContext, LevelQ int, dd DigitDecomposition, t *testing.T) {
@@ -839,6 +1503,79 @@ func testAutomorphism(tc *TestContext, LevelQ int, dd DigitDecomposition, t *tes
 			require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
 		})
 
+		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/RotateByGaloisElementNew"), func(t *testing.T) {
+
+			// Generate a plaintext with values up to 2^30
+			pt := genPlaintext(params, LevelQ, 1<<30)
+
+			// Encrypt
+			ct := NewCiphertext(params, 1, LevelQ, -1)
+			require.NoError(t, enc.Encrypt(pt, ct))
+
+			// Chooses a Galois Element (must be coprime with 2N)
+			galEl := params.GaloisElement(-1)
+
+			// The GaloisKey must be present in the EvaluationKeySet
+			_, err := eval.RotateByGaloisElementNew(ct, galEl)
+			require.ErrorIs(t, err, ErrMissingGaloisKey)
+
+			// Allocate a new EvaluationKeySet and adds the GaloisKey
+			evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeyNew(galEl, sk, evkParams))
+
+			// Evaluate the automorphism
+			res, err := eval.WithKey(evk).RotateByGaloisElementNew(ct, galEl)
+			require.NoError(t, err)
+
+			// Apply the same automorphism on the plaintext
+			ringQ := params.RingQ().AtLevel(LevelQ)
+
+			tmp := ringQ.NewRNSPoly()
+			if pt.IsNTT {
+				ringQ.AutomorphismNTT(pt.Q, galEl, tmp)
+			} else {
+				ringQ.Automorphism(pt.Q, galEl, tmp)
+			}
+
+			// Decrypt
+			dec.Decrypt(res, pt)
+
+			// Subract the permuted plaintext to the decrypted plaintext
+			ringQ.Sub(pt.Q, tmp, pt.Q)
+
+			// Switch out of NTT if required
+			if pt.IsNTT {
+				ringQ.INTT(pt.Q, pt.Q)
+			}
+
+			// Logs the noise
+			require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+		})
+
+		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/PrecomputeAutomorphismIndex"), func(t *testing.T) {
+
+			galEls := params.GaloisElements([]int{-2, -1, 1, 2})
+
+			// A keyless Evaluator holds no cached look-up tables
+			eval := NewEvaluator(params, nil)
+			for _, galEl := range galEls {
+				require.Nil(t, eval.AutomorphismIndex(galEl))
+			}
+
+			eval.PrecomputeAutomorphismIndex(galEls)
+
+			// The cached tables must match a direct computation
+			for _, galEl := range galEls {
+				want, err := ring.AutomorphismNTTIndex(params.N(), params.RingQ().NthRoot(), galEl)
+				require.NoError(t, err)
+				require.Equal(t, want, eval.AutomorphismIndex(galEl))
+			}
+
+			// Warming the cache twice must reuse the existing tables
+			index := eval.AutomorphismIndex(galEls[0])
+			eval.PrecomputeAutomorphismIndex(galEls[:1])
+			require.Equal(t, &index[0], &eval.AutomorphismIndex(galEls[0])[0])
+		})
+
 		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/AutomorphismHoisted"), func(t *testing.T) {
 
 			if dd.Type != 0 {
@@ -895,6 +1632,75 @@ func testAutomorphism(tc *TestContext, LevelQ int, dd DigitDecomposition, t *tes
 			require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
 		})
 
+		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/AutomorphismMany"), func(t *testing.T) {
+
+			if dd.Type != 0 {
+				t.Skip("method is not supported if BaseTwoDecomposition != 0")
+			}
+
+			if tc.params.MaxLevelP() == -1 {
+				t.Skip("test requires #P > 0")
+			}
+
+			// Generate a plaintext with values up to 2^30
+			pt := genPlaintext(params, LevelQ, 1<<30)
+
+			// Encrypt
+			ct := NewCiphertext(params, 1, LevelQ, -1)
+			require.NoError(t, enc.Encrypt(pt, ct))
+
+			// Chooses a set of Galois Elements (must be coprime with 2N)
+			galEls := []uint64{params.GaloisElement(-1), params.GaloisElement(1), params.GaloisElement(2)}
+
+			// Allocate a new EvaluationKeySet and adds the GaloisKeys
+			evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(galEls, sk, evkParams)...)
+
+			Hbuf := eval.NewHoistingBuffer(LevelQ, LevelP)
+
+			// Decompose the ciphertext
+			eval.FillHoistingBuffer(LevelQ, LevelP, ct.Q[1], ct.IsNTT, Hbuf)
+
+			// Missing GaloisKeys are reported up front in a single error
+			partial := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeyNew(galEls[0], sk, evkParams))
+			err := eval.WithKey(partial).AutomorphismMany(ct, Hbuf, galEls, map[uint64]*Ciphertext{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), fmt.Sprintf("%v", galEls[1:]))
+
+			// Evaluate the automorphisms, letting the method allocate the outputs
+			cts := map[uint64]*Ciphertext{}
+			require.NoError(t, eval.WithKey(evk).AutomorphismMany(ct, Hbuf, galEls, cts))
+			require.Equal(t, len(galEls), len(cts))
+
+			ringQ := params.RingQ().AtLevel(LevelQ)
+
+			tmp := ringQ.NewRNSPoly()
+			ptHave := NewPlaintext(params, LevelQ, -1)
+
+			for _, galEl := range galEls {
+
+				// Apply the same automorphism on the plaintext
+				if pt.IsNTT {
+					ringQ.AutomorphismNTT(pt.Q, galEl, tmp)
+				} else {
+					ringQ.Automorphism(pt.Q, galEl, tmp)
+				}
+
+				// Decrypt
+				dec.Decrypt(cts[galEl], ptHave)
+
+				// Subract the permuted plaintext to the decrypted plaintext
+				ringQ.Sub(ptHave.Q, tmp, ptHave.Q)
+
+				// Switch out of NTT if required
+				if ptHave.IsNTT {
+					ringQ.INTT(ptHave.Q, ptHave.Q)
+				}
+
+				// Logs the noise
+				require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(ptHave.Q)[0])
+			}
+		})
+
 		t.Run(testString(params, LevelQ, LevelP, dd, "Evaluator/AutomorphismHoistedLazy"), func(t *testing.T) {
 
 			if dd.Type != 0 {
@@ -986,7 +1792,9 @@ func testSlotOperations(tc *TestContext, LevelQ int, dd DigitDecomposition, t *t
 		require.NoError(t, enc.Encrypt(pt, ct))
 
 		// Galois Keys
-		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(GaloisElementsForInnerSum(params, batch, n), sk)...)
+		require.ElementsMatch(t, GaloisElementsForInnerSum(params, batch, n), eval.InnerSumGaloisElements(batch, n))
+		require.Equal(t, 5, eval.InnerSumDepth(n)) // log2(7) + HW(7) = 2 + 3
+		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(eval.InnerSumGaloisElements(batch, n), sk)...)
 
 		Hbuf := eval.NewHoistingBuffer(LevelQ, LevelP)
 
@@ -1017,6 +1825,114 @@ func testSlotOperations(tc *TestContext, LevelQ int, dd DigitDecomposition, t *t
 		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
 
 	})
+
+	t.Run(testString(params, LevelQ, params.MaxLevelP(), dd, "Evaluator/SumAllSlots"), func(t *testing.T) {
+
+		if params.MaxLevelP() == -1 {
+			t.Skip("test requires #P > 0")
+		}
+
+		LevelP := params.MaxLevelP()
+
+		logSlots := 3
+
+		ringQ := tc.params.RingQ().AtLevel(LevelQ)
+
+		pt := genPlaintext(params, LevelQ, 1<<30)
+		ptSum := *pt.Q.Clone()
+		ct := NewCiphertext(params, 1, LevelQ, -1)
+		require.NoError(t, enc.Encrypt(pt, ct))
+		ct.LogDimensions = ring.Dimensions{Rows: 0, Cols: logSlots}
+
+		slots := ct.Slots()
+
+		// Galois Keys (only the power-of-two rotations)
+		require.ElementsMatch(t, GaloisElementsForSumAllSlots(params, slots), eval.InnerSumGaloisElements(1, slots))
+		evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(GaloisElementsForSumAllSlots(params, slots), sk)...)
+
+		Hbuf := eval.NewHoistingBuffer(LevelQ, LevelP)
+
+		require.NoError(t, eval.WithKey(evk).SumAllSlots(ct, Hbuf, ct))
+
+		dec.Decrypt(ct, pt)
+
+		if pt.IsNTT {
+			ringQ.INTT(pt.Q, pt.Q)
+			ringQ.INTT(ptSum, ptSum)
+		}
+
+		polyTmp := ringQ.NewRNSPoly()
+
+		// Applies the same circuit (naively) on the plaintext
+		polySum := *ptSum.Clone()
+		for i := 1; i < slots; i++ {
+			galEl := params.GaloisElement(i)
+			ringQ.Automorphism(ptSum, galEl, polyTmp)
+			ringQ.Add(polySum, polyTmp, polySum)
+		}
+
+		ringQ.Sub(pt.Q, polySum, pt.Q)
+
+		NoiseBound := float64(params.LogN())
+
+		// Logs the noise
+		require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+
+	})
+
+	t.Run(testString(params, LevelQ, params.MaxLevelP(), dd, "Evaluator/Replicate"), func(t *testing.T) {
+
+		if params.MaxLevelP() == -1 {
+			t.Skip("test requires #P > 0")
+		}
+
+		LevelP := params.MaxLevelP()
+
+		batch := 3
+
+		ringQ := tc.params.RingQ().AtLevel(LevelQ)
+
+		Hbuf := eval.NewHoistingBuffer(LevelQ, LevelP)
+
+		// Checks the correctness for non-power-of-two n, whose binary
+		// decomposition exercises the wrap-around path of the inner sum.
+		for _, n := range []int{3, 5, 7} {
+
+			pt := genPlaintext(params, LevelQ, 1<<30)
+			ptReplicate := *pt.Q.Clone()
+			ct := NewCiphertext(params, 1, LevelQ, -1)
+			require.NoError(t, enc.Encrypt(pt, ct))
+
+			// Galois Keys
+			evk := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeysNew(GaloisElementsForReplicate(params, batch, n), sk)...)
+
+			require.NoError(t, eval.WithKey(evk).Replicate(ct, batch, n, Hbuf, ct))
+
+			dec.Decrypt(ct, pt)
+
+			if pt.IsNTT {
+				ringQ.INTT(pt.Q, pt.Q)
+				ringQ.INTT(ptReplicate, ptReplicate)
+			}
+
+			polyTmp := ringQ.NewRNSPoly()
+
+			// Applies the same circuit (naively) on the plaintext
+			polyReplicate := *ptReplicate.Clone()
+			for i := 1; i < n; i++ {
+				galEl := params.GaloisElement(i * -batch)
+				ringQ.Automorphism(ptReplicate, galEl, polyTmp)
+				ringQ.Add(polyReplicate, polyTmp, polyReplicate)
+			}
+
+			ringQ.Sub(pt.Q, polyReplicate, pt.Q)
+
+			NoiseBound := float64(params.LogN())
+
+			// Logs the noise
+			require.GreaterOrEqual(t, NoiseBound, ringQ.Stats(pt.Q)[0])
+		}
+	})
 }
 
 func genPlaintext(params Parameters, level, max int) (pt *Plaintext) {
@@ -1066,6 +1982,70 @@ func testWriteAndRead(tc *TestContext, dd DigitDecomposition, t *testing.T) {
 		}
 	})
 
+	t.Run(testString(params, LevelQ, LevelP, dd, "WriteAndRead/Ciphertext/CoeffDomain"), func(t *testing.T) {
+
+		rQ := params.RingQ()
+		rP := params.RingP()
+
+		op := NewCiphertext(params, 1, LevelQ, LevelP)
+		op.Randomize(params, sampling.NewSource([32]byte{}))
+		op.IsNTT = true
+
+		buf := buffer.NewBufferSize(op.BinarySize())
+
+		_, err := op.WriteToCoeffDomain(buf, rQ, rP)
+		require.NoError(t, err)
+
+		// The stream stores the polynomials in the coefficient domain
+		stored := new(Ciphertext)
+		_, err = stored.ReadFrom(buffer.NewBuffer(buf.Bytes()))
+		require.NoError(t, err)
+		require.True(t, stored.IsNTT)
+		require.False(t, stored.Equal(op))
+
+		// The round-trip restores the NTT domain
+		have := new(Ciphertext)
+		_, err = have.ReadFromCoeffDomain(buffer.NewBuffer(buf.Bytes()), rQ, rP)
+		require.NoError(t, err)
+		require.True(t, have.Equal(op))
+
+		// Coefficient-domain ciphertexts are stored as-is
+		op.ToCoeffDomain(rQ, rP)
+		require.False(t, op.IsNTT)
+
+		buf = buffer.NewBufferSize(op.BinarySize())
+		_, err = op.WriteToCoeffDomain(buf, rQ, rP)
+		require.NoError(t, err)
+
+		have = new(Ciphertext)
+		_, err = have.ReadFromCoeffDomain(buffer.NewBuffer(buf.Bytes()), rQ, rP)
+		require.NoError(t, err)
+		require.True(t, have.Equal(op))
+	})
+
+	t.Run(testString(params, LevelQ, LevelP, dd, "WriteAndRead/CodecHeader"), func(t *testing.T) {
+
+		op := NewCiphertext(params, 1, LevelQ, LevelP)
+		op.Randomize(params, sampling.NewSource([32]byte{}))
+
+		data, err := op.MarshalBinary()
+		require.NoError(t, err)
+
+		// An unknown version must be rejected with a clear error
+		corrupted := slices.Clone(data)
+		corrupted[4] = CodecVersion + 1
+		err = new(Ciphertext).UnmarshalBinary(corrupted)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported serialization version")
+
+		// Data without the magic prefix must be rejected with a clear error
+		corrupted = slices.Clone(data)
+		corrupted[0] ^= 0xFF
+		err = new(Ciphertext).UnmarshalBinary(corrupted)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "magic prefix")
+	})
+
 	t.Run(testString(params, LevelQ, LevelP, dd, "WriteAndRead/GadgetCiphertext"), func(t *testing.T) {
 		rlk := NewRelinearizationKey(params, EvaluationKeyParameters{DigitDecomposition: dd})
 		tc.kgen.GenRelinearizationKey(tc.sk, rlk)
@@ -1099,6 +2079,77 @@ func testWriteAndRead(tc *TestContext, dd DigitDecomposition, t *testing.T) {
 			GaloisKeys:         map[uint64]*GaloisKey{galEl: tc.kgen.GenGaloisKeyNew(galEl, tc.sk)},
 		})
 	})
+
+	t.Run(testString(params, LevelQ, LevelP, dd, "WriteAndRead/EvaluationKeySetWithDigest"), func(t *testing.T) {
+		galEl := uint64(5)
+		evk := &MemEvaluationKeySet{
+			RelinearizationKey: tc.kgen.GenRelinearizationKeyNew(tc.sk),
+			GaloisKeys:         map[uint64]*GaloisKey{galEl: tc.kgen.GenGaloisKeyNew(galEl, tc.sk)},
+		}
+
+		p, err := evk.MarshalBinaryWithDigest()
+		require.NoError(t, err)
+
+		evkRec := &MemEvaluationKeySet{}
+		require.NoError(t, evkRec.UnmarshalBinaryWithDigest(p))
+		require.True(t, evk.RelinearizationKey.Equal(evkRec.RelinearizationKey))
+		require.True(t, evk.GaloisKeys[galEl].Equal(evkRec.GaloisKeys[galEl]))
+
+		// Flips one bit of the key material: the digest verification must fail
+		p[len(p)/2] ^= 1
+		require.Error(t, evkRec.UnmarshalBinaryWithDigest(p))
+		p[len(p)/2] ^= 1
+
+		// Flips one bit of the digest: the verification must fail
+		p[len(p)-1] ^= 1
+		require.Error(t, evkRec.UnmarshalBinaryWithDigest(p))
+
+		require.Error(t, evkRec.UnmarshalBinaryWithDigest(nil))
+	})
+
+	t.Run(testString(params, LevelQ, LevelP, dd, "WriteAndRead/Context"), func(t *testing.T) {
+		galEl := uint64(5)
+		evk := &MemEvaluationKeySet{
+			RelinearizationKey: tc.kgen.GenRelinearizationKeyNew(tc.sk),
+			GaloisKeys:         map[uint64]*GaloisKey{galEl: tc.kgen.GenGaloisKeyNew(galEl, tc.sk)},
+		}
+
+		buf := new(bytes.Buffer)
+		require.NoError(t, SaveContext(buf, params, tc.sk, evk))
+
+		paramsRec, skRec, evkRec, err := LoadContext(buf)
+		require.NoError(t, err)
+		require.True(t, params.Equal(&paramsRec))
+		require.True(t, tc.sk.Equal(skRec))
+		require.True(t, evk.RelinearizationKey.Equal(evkRec.RelinearizationKey))
+		require.True(t, evk.GaloisKeys[galEl].Equal(evkRec.GaloisKeys[galEl]))
+
+		// keys are optional and absent from the archive if nil
+		buf.Reset()
+		require.NoError(t, SaveContext(buf, params, nil, nil))
+		paramsRec, skRec, evkRec, err = LoadContext(buf)
+		require.NoError(t, err)
+		require.True(t, params.Equal(&paramsRec))
+		require.Nil(t, skRec)
+		require.Nil(t, evkRec)
+
+		// a secret key generated under different parameters must be rejected on load
+		paramsSmall, err := NewParametersFromLiteral(ParametersLiteral{
+			LogN: params.LogN() - 1,
+			Q:    params.Q(),
+			P:    params.P(),
+		})
+		require.NoError(t, err)
+
+		buf.Reset()
+		require.NoError(t, SaveContext(buf, params, NewKeyGenerator(paramsSmall).GenSecretKeyNew(), nil))
+		_, _, _, err = LoadContext(buf)
+		require.Error(t, err)
+
+		// an archive without manifest must be rejected
+		_, _, _, err = LoadContext(new(bytes.Buffer))
+		require.Error(t, err)
+	})
 }
 
 func testMarshaller(tc *TestContext, t *testing.T) {
@@ -1124,3 +2175,122 @@ func testMarshaller(tc *TestContext, t *testing.T) {
 		buffer.RequireSerializerCorrect(t, &params)
 	})
 }
+
+func testEvaluationKeySetMerge(tc *TestContext, t *testing.T) {
+
+	params := tc.params
+	kgen := tc.kgen
+	sk := tc.sk
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), DigitDecomposition{}, "EvaluationKeySet/Merge"), func(t *testing.T) {
+
+		galEl0 := params.GaloisElement(1)
+		galEl1 := params.GaloisElement(2)
+
+		evk0 := NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk), kgen.GenGaloisKeyNew(galEl0, sk))
+		evk1 := NewMemEvaluationKeySet(nil, kgen.GenGaloisKeyNew(galEl1, sk))
+
+		require.NoError(t, evk0.Merge(evk1, false))
+
+		_, err := evk0.GetGaloisKey(galEl0)
+		require.NoError(t, err)
+		_, err = evk0.GetGaloisKey(galEl1)
+		require.NoError(t, err)
+		_, err = evk0.GetRelinearizationKey()
+		require.NoError(t, err)
+
+		// Conflicting Galois element
+		require.Error(t, evk0.Merge(evk1, false))
+		require.NoError(t, evk0.Merge(evk1, true))
+
+		// Conflicting RelinearizationKey
+		evk2 := NewMemEvaluationKeySet(kgen.GenRelinearizationKeyNew(sk))
+		require.Error(t, evk0.Merge(evk2, false))
+		require.NoError(t, evk0.Merge(evk2, true))
+	})
+}
+
+func testEvaluationKeySetIncremental(tc *TestContext, t *testing.T) {
+
+	params := tc.params
+	kgen := tc.kgen
+	sk := tc.sk
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), DigitDecomposition{}, "EvaluationKeySet/Incremental"), func(t *testing.T) {
+
+		galEl0 := params.GaloisElement(1)
+		galEl1 := params.GaloisElement(2)
+
+		evk := &MemEvaluationKeySet{}
+
+		// Keys that have not been added yet must be reported as missing
+		_, err := evk.GetGaloisKey(galEl0)
+		require.Error(t, err)
+		_, err = evk.GetRelinearizationKey()
+		require.Error(t, err)
+
+		evk.AddGaloisKey(kgen.GenGaloisKeyNew(galEl0, sk))
+
+		_, err = evk.GetGaloisKey(galEl0)
+		require.NoError(t, err)
+		_, err = evk.GetGaloisKey(galEl1)
+		require.Error(t, err)
+
+		evk.AddGaloisKey(kgen.GenGaloisKeyNew(galEl1, sk))
+		evk.SetRelinearizationKey(kgen.GenRelinearizationKeyNew(sk))
+
+		_, err = evk.GetGaloisKey(galEl1)
+		require.NoError(t, err)
+		_, err = evk.GetRelinearizationKey()
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uint64{galEl0, galEl1}, evk.GetGaloisKeysList())
+	})
+}
+
+func testClassifyOperand(tc *TestContext, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), DigitDecomposition{}, "ClassifyOperand"), func(t *testing.T) {
+
+		require.Equal(t, OperandElement, ClassifyOperand(NewCiphertext(params, 1, params.MaxLevelQ(), -1)))
+		require.Equal(t, OperandElement, ClassifyOperand(NewPlaintext(params, params.MaxLevelQ(), -1)))
+
+		for _, scalar := range []Operand{complex(1, 0), 1.0, 1, int64(1), uint(1), uint64(1), new(big.Int), new(big.Float)} {
+			require.Equal(t, OperandScalar, ClassifyOperand(scalar))
+		}
+
+		for _, vector := range []Operand{[]complex128{}, []float64{}, []int64{}, []uint64{}, []big.Float{}} {
+			require.Equal(t, OperandVector, ClassifyOperand(vector))
+		}
+
+		require.Equal(t, OperandUnknown, ClassifyOperand("not an operand"))
+		require.Equal(t, OperandUnknown, ClassifyOperand(nil))
+	})
+}
+
+func testSentinelErrors(tc *TestContext, t *testing.T) {
+
+	params := tc.params
+
+	t.Run(testString(params, params.MaxLevelQ(), params.MaxLevelP(), DigitDecomposition{}, "SentinelErrors"), func(t *testing.T) {
+
+		galEl := params.GaloisElement(1)
+
+		// Evaluator with an empty key set
+		eval := tc.eval.WithKey(&MemEvaluationKeySet{})
+
+		_, err := eval.CheckAndGetGaloisKey(galEl)
+		require.ErrorIs(t, err, ErrMissingGaloisKey)
+		_, err = eval.CheckAndGetRelinearizationKey()
+		require.ErrorIs(t, err, ErrMissingRelinKey)
+
+		// Evaluator with a nil key set interface
+		eval = NewEvaluator(params, nil)
+
+		_, err = eval.CheckAndGetGaloisKey(galEl)
+		require.ErrorIs(t, err, ErrMissingGaloisKey)
+		_, err = eval.CheckAndGetRelinearizationKey()
+		require.ErrorIs(t, err, ErrMissingRelinKey)
+	})
+}
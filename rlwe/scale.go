@@ -2,6 +2,7 @@ package rlwe
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -180,13 +181,21 @@ func (s Scale) BinarySize() int {
 	return (ScalePrecisionLog10 + 6) << 1
 }
 
+// MarshalJSON encodes the object as a JSON string holding its binary form.
 func (s Scale) MarshalJSON() (p []byte, err error) {
-	p, err = s.MarshalBinary()
-	return
+	if p, err = s.MarshalBinary(); err != nil {
+		return
+	}
+	return json.Marshal(string(p))
 }
 
-func (s Scale) UnnMarshalJSON(p []byte) (err error) {
-	return s.UnmarshalBinary(p)
+// UnmarshalJSON decodes a JSON string generated by MarshalJSON on the object.
+func (s *Scale) UnmarshalJSON(p []byte) (err error) {
+	var str string
+	if err = json.Unmarshal(p, &str); err != nil {
+		return
+	}
+	return s.UnmarshalBinary([]byte(str))
 }
 
 // WriteTo writes the object on an io.Writer. It implements the io.WriterTo
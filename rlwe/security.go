@@ -1,6 +1,12 @@
 package rlwe
 
-import "github.com/Pro7ech/lattigo/ring"
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/Pro7ech/lattigo/ring"
+)
 
 const (
 	// XsUniformTernary is the standard deviation of a ternary key with uniform distribution
@@ -17,3 +23,65 @@ const (
 var DefaultXe = ring.DiscreteGaussian{Sigma: DefaultNoise, Bound: DefaultNoiseBound}
 
 var DefaultXs = ring.Ternary{P: 2 / 3.0}
+
+// LWEParams regroups the parameters of the underlying LWE instance of a set of
+// [Parameters] in the format expected by the lattice estimator
+// (https://github.com/malb/lattice-estimator).
+//
+// It does not compute a security estimate itself: the user is expected to feed
+// these values (e.g. via [LWEParams.String]) to the estimator.
+type LWEParams struct {
+	N    int                         // LWE dimension (i.e. the ring degree)
+	Q    *big.Int                    // full modulus of the instance (QP if an auxiliary modulus P is used)
+	LogQ float64                     // base two logarithm of Q
+	Xs   ring.DistributionParameters // distribution of the secret
+	Xe   ring.DistributionParameters // distribution of the error
+}
+
+// SecurityEstimate returns the parameters of the underlying LWE instance in the
+// format expected by the lattice estimator (https://github.com/malb/lattice-estimator).
+//
+// The returned modulus is the full modulus QP, since the evaluation keys are
+// encrypted under QP. The method does not compute a security estimate itself,
+// see [LWEParams.String] for a ready-to-run estimator snippet.
+func (p Parameters) SecurityEstimate() LWEParams {
+	return LWEParams{
+		N:    p.N(),
+		Q:    p.QPBigInt(),
+		LogQ: p.LogQP(),
+		Xs:   p.Xs(),
+		Xe:   p.Xe(),
+	}
+}
+
+// String returns a Python snippet instantiating the lattice estimator
+// (https://github.com/malb/lattice-estimator) with the receiver's parameters.
+func (p LWEParams) String() string {
+	return fmt.Sprintf("from estimator import *\nparams = LWE.Parameters(n=%d, q=%s, Xs=%s, Xe=%s)\nLWE.estimate(params)\n",
+		p.N,
+		p.Q.String(),
+		estimatorNoiseDistribution(p.Xs, p.N),
+		estimatorNoiseDistribution(p.Xe, p.N))
+}
+
+// estimatorNoiseDistribution maps a [ring.DistributionParameters] to the
+// corresponding noise distribution constructor of the lattice estimator.
+func estimatorNoiseDistribution(d ring.DistributionParameters, n int) string {
+	switch d := d.(type) {
+	case *ring.DiscreteGaussian:
+		return fmt.Sprintf("ND.DiscreteGaussian(%f)", d.Sigma)
+	case *ring.Ternary:
+		h := d.H
+		if h == 0 {
+			if d.P == 2/3.0 {
+				return "ND.Uniform(-1, 1)"
+			}
+			h = int(math.Ceil(float64(n) * d.P))
+		}
+		return fmt.Sprintf("ND.SparseTernary(%d, %d, n=%d)", h>>1, h-h>>1, n)
+	case *ring.Uniform:
+		return "ND.UniformMod(q)"
+	default:
+		panic(fmt.Sprintf("invalid distribution: must be *ring.DiscreteGaussian, *ring.Ternary or *ring.Uniform but is %T", d))
+	}
+}
@@ -0,0 +1,50 @@
+package rlwe
+
+import (
+	"sync"
+
+	"github.com/Pro7ech/lattigo/utils/sampling"
+)
+
+// testRandomness, when set through [SetTestRandomness], replaces the
+// cryptographically secure seeding of the package's samplers with a
+// deterministic seed chain.
+var testRandomness struct {
+	sync.Mutex
+	source *sampling.Source
+}
+
+// SetTestRandomness makes all randomness drawn by the package (key-generation,
+// encryption and decryption noise) deterministic by deriving the seeds of all
+// subsequently instantiated samplers from the provided seed.
+//
+// This is an explicit opt-in intended exclusively for tests and benchmarks that
+// assert exact noise bounds and must be reproducible across runs and
+// architectures. It MUST NOT be used in production as it voids all security.
+//
+// The default cryptographically secure seeding can be restored with
+// [UnsetTestRandomness].
+func SetTestRandomness(seed [32]byte) {
+	testRandomness.Lock()
+	defer testRandomness.Unlock()
+	testRandomness.source = sampling.NewSource(seed)
+}
+
+// UnsetTestRandomness restores the default cryptographically secure seeding
+// of the package's samplers after a call to [SetTestRandomness].
+func UnsetTestRandomness() {
+	testRandomness.Lock()
+	defer testRandomness.Unlock()
+	testRandomness.source = nil
+}
+
+// newSeed returns a seed for a fresh sampler: a cryptographically secure seed
+// by default, or a deterministic one if [SetTestRandomness] has been called.
+func newSeed() [32]byte {
+	testRandomness.Lock()
+	defer testRandomness.Unlock()
+	if testRandomness.source != nil {
+		return testRandomness.source.NewSeed()
+	}
+	return sampling.NewSeed()
+}
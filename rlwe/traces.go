@@ -3,6 +3,7 @@ package rlwe
 import (
 	"fmt"
 	"math/big"
+	"math/bits"
 
 	"github.com/Pro7ech/lattigo/ring"
 )
@@ -545,13 +546,52 @@ func GaloisElementsForInnerSum(params ParameterProvider, batch, n int) (galEls [
 	return params.GetRLWEParameters().GaloisElements(rotations)
 }
 
+// InnerSumGaloisElements returns the list of Galois elements necessary to apply the method
+// `InnerSum` with parameters `batch` and `n`. It is a shorthand for `GaloisElementsForInnerSum`
+// instantiated with the evaluator's parameters, so that the key-generation code and the
+// evaluation code can share a single source of truth.
+func (eval Evaluator) InnerSumGaloisElements(batch, n int) (galEls []uint64) {
+	return GaloisElementsForInnerSum(eval.GetRLWEParameters(), batch, n)
+}
+
+// InnerSumDepth returns the number of rotations (and thus of key-switching operations)
+// performed by the method `InnerSum` for the given n, i.e. log2(n) + HW(n).
+func (eval Evaluator) InnerSumDepth(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len64(uint64(n)) - 1 + bits.OnesCount64(uint64(n))
+}
+
+// SumAllSlots sums all the slots of ctIn and returns in opOut a Ciphertext in which
+// every slot holds the total sum. It is a shorthand for [Evaluator.InnerSum] with
+// batchSize=1 and n=ctIn.Slots(), for which the inner-sum tree degenerates into a
+// pure rotate-and-add doubling tree: the method performs log2(slots) rotations and
+// requires only the power-of-two rotation keys, whose Galois elements can be obtained
+// with [GaloisElementsForSumAllSlots].
+// The number of slots is read from the ctIn [rlwe.MetaData].
+func (eval Evaluator) SumAllSlots(ctIn *Ciphertext, buf HoistingBuffer, opOut *Ciphertext) (err error) {
+	return eval.InnerSum(ctIn, 1, ctIn.Slots(), buf, opOut)
+}
+
+// GaloisElementsForSumAllSlots returns the list of Galois elements necessary to apply
+// the method `SumAllSlots` on a Ciphertext with the given number of slots, i.e. the
+// Galois elements of the power-of-two rotations 1, 2, 4, ..., slots/2.
+func GaloisElementsForSumAllSlots(params ParameterProvider, slots int) (galEls []uint64) {
+	return GaloisElementsForInnerSum(params, 1, slots)
+}
+
 // Replicate applies an optimized replication on the Ciphertext (log2(n) + HW(n) rotations with double hoisting).
 // It acts as the inverse of a inner sum (summing elements from left to right).
 // The replication is parameterized by the size of the sub-vectors to replicate "batchSize" and
 // the number of times 'n' they need to be replicated.
 // To ensure correctness, a gap of zero values of size batchSize * (n-1) must exist between
 // two consecutive sub-vectors to replicate.
-// This method is faster than Replicate when the number of rotations is large and it uses log2(n) + HW(n) instead of 'n'.
+// The number of times 'n' can be arbitrary (i.e. it does not have to be a power of two):
+// the replication is computed by decomposing n into its binary representation, exactly
+// like InnerSum does.
+// This method is faster than naive replication when the number of rotations is large and
+// it uses log2(n) + HW(n) rotations instead of 'n'.
 func (eval Evaluator) Replicate(ctIn *Ciphertext, batchSize, n int, buf HoistingBuffer, opOut *Ciphertext) (err error) {
 	return eval.InnerSum(ctIn, -batchSize, n, buf, opOut)
 }
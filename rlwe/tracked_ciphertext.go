@@ -0,0 +1,62 @@
+package rlwe
+
+import (
+	"fmt"
+	"math/big"
+	"runtime/debug"
+)
+
+// ScaleTracking enables the runtime scale assertions performed on [rlwe.TrackedCiphertext].
+// When disabled (the default), AssertScale is a no-op, so tracked evaluator methods only
+// carry the intended scale along without any runtime cost beyond the bookkeeping itself.
+var ScaleTracking = false
+
+// ScaleTrackingTolerance is the maximum tolerated relative difference between the
+// intended scale and the actual scale of a [rlwe.TrackedCiphertext] before
+// AssertScale panics (when [rlwe.ScaleTracking] is enabled).
+var ScaleTrackingTolerance = 1e-9
+
+// TrackedCiphertext wraps an [rlwe.Ciphertext] together with its intended scale,
+// tracked as an arbitrary-precision rational. Evaluators expose *Tracked variants
+// of their operations that update the intended scale analytically, allowing scale
+// drift to be caught early during development by enabling [rlwe.ScaleTracking].
+type TrackedCiphertext struct {
+	*Ciphertext
+	// TrackedScale is the intended scale of the Ciphertext.
+	TrackedScale big.Rat
+}
+
+// NewTrackedCiphertext wraps the provided Ciphertext into a [rlwe.TrackedCiphertext],
+// initializing the intended scale from the Ciphertext's current scale.
+func NewTrackedCiphertext(ct *Ciphertext) (tct *TrackedCiphertext) {
+	tct = &TrackedCiphertext{Ciphertext: ct}
+	rat, _ := ct.Scale.Value.Rat(nil)
+	tct.TrackedScale.Set(rat)
+	return
+}
+
+// AssertScale compares the intended scale with the actual scale of the underlying
+// Ciphertext and panics with a stack trace if the relative difference exceeds
+// [rlwe.ScaleTrackingTolerance]. The method is a no-op unless [rlwe.ScaleTracking]
+// is enabled.
+func (ct *TrackedCiphertext) AssertScale() {
+
+	if !ScaleTracking {
+		return
+	}
+
+	want := new(big.Float).SetPrec(ScalePrecision).SetRat(&ct.TrackedScale)
+	have := &ct.Scale.Value
+
+	diff := new(big.Float).Sub(want, have)
+	diff.Abs(diff)
+
+	if want.Sign() != 0 {
+		diff.Quo(diff, want)
+	}
+
+	if diff.Cmp(big.NewFloat(ScaleTrackingTolerance)) > 0 {
+		panic(fmt.Sprintf("scale drift detected: intended scale %s but actual scale %s\n%s",
+			want.Text('e', 10), have.Text('e', 10), debug.Stack()))
+	}
+}
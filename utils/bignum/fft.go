@@ -0,0 +1,95 @@
+package bignum
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// FFT evaluates in-place the discrete Fourier transform of values, whose length
+// must be a power of two, with arbitrary precision complex arithmetic.
+// The precision of the computation is the precision of the first element of values.
+//
+// The forward transform (inverse=false) evaluates X[k] = sum_{j} x[j] * e^{-2*pi*i*j*k/n}
+// and the inverse transform (inverse=true) its normalized inverse, i.e. applying FFT
+// with inverse=false and then inverse=true returns the original values (up to the
+// precision of the computation).
+//
+// This is the standard transform over the n-th roots of unity; the encoding and
+// decoding transforms of the hefloat scheme, which are evaluated over the odd
+// powers of the 4n-th roots of unity, remain available through the hefloat.Encoder.
+func FFT(values []Complex, inverse bool) {
+
+	n := len(values)
+
+	if n&(n-1) != 0 {
+		panic(fmt.Sprintf("invalid call of FFT: len(values)=%d must be a power of two", n))
+	}
+
+	if n < 2 {
+		return
+	}
+
+	prec := values[0].Prec()
+
+	roots := fftRoots(n, prec, inverse)
+
+	// Bit-reversal permutation
+	logN := bits.Len64(uint64(n)) - 1
+	for i := 0; i < n; i++ {
+		if j := int(bits.Reverse64(uint64(i)) >> (64 - logN)); i < j {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+
+	cmul := NewComplexMultiplier()
+	tmp := &Complex{}
+
+	// Iterative radix-2 Cooley-Tukey
+	for m := 2; m <= n; m <<= 1 {
+		for i := 0; i < n; i += m {
+			for j := 0; j < m>>1; j++ {
+				u := &values[i+j]
+				v := &values[i+j+m>>1]
+				cmul.Mul(v, &roots[j*(n/m)], tmp)
+				v.Sub(u, tmp)
+				u.Add(u, tmp)
+			}
+		}
+	}
+
+	if inverse {
+		nBig := NewFloat(float64(n), prec)
+		for i := range values {
+			values[i][0].Quo(&values[i][0], nBig)
+			values[i][1].Quo(&values[i][1], nBig)
+		}
+	}
+}
+
+// fftRoots returns the n/2 first n-th roots of unity e^{-2*pi*i*k/n} (conjugated
+// if inverse=true) with prec bits of precision.
+func fftRoots(n int, prec uint, inverse bool) (roots []Complex) {
+
+	roots = make([]Complex, n>>1)
+
+	twoPiOverN := NewFloat(2, prec)
+	twoPiOverN.Mul(twoPiOverN, Pi(prec))
+	twoPiOverN.Quo(twoPiOverN, NewFloat(float64(n), prec))
+
+	angle := new(big.Float).SetPrec(prec)
+
+	roots[0][0].SetPrec(prec).SetInt64(1)
+	roots[0][1].SetPrec(prec)
+
+	for k := 1; k < n>>1; k++ {
+		angle.Mul(twoPiOverN, NewFloat(float64(k), prec))
+		roots[k][0].Set(Cos(angle))
+		roots[k][1].Set(Sin(angle))
+		if !inverse {
+			roots[k][1].Neg(&roots[k][1])
+		}
+	}
+
+	return
+}
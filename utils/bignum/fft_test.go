@@ -0,0 +1,61 @@
+package bignum
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFT(t *testing.T) {
+
+	prec := uint(128)
+	n := 64
+
+	t.Run("Forward", func(t *testing.T) {
+
+		// X[k] of the canonical basis vector x[j] = delta_{j,1}
+		// is the k-th power of the primitive n-th root of unity
+		values := make([]Complex, n)
+		for i := range values {
+			values[i].SetPrec(prec)
+		}
+		values[1][0].SetInt64(1)
+
+		FFT(values, false)
+
+		for k := range values {
+			want := cmplx.Exp(complex(0, -2*math.Pi*float64(k)/float64(n)))
+			have := values[k].Complex128()
+			require.InDelta(t, real(want), real(have), 1e-15)
+			require.InDelta(t, imag(want), imag(have), 1e-15)
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+
+		values := make([]Complex, n)
+		want := make([]Complex, n)
+		for i := range values {
+			values[i].SetPrec(prec)
+			values[i][0].SetFloat64(math.Cos(float64(7*i*i + 1)))
+			values[i][1].SetFloat64(math.Sin(float64(3*i + 2)))
+			want[i].SetPrec(prec).Set(&values[i])
+		}
+
+		FFT(values, false)
+		FFT(values, true)
+
+		// The round-trip must be exact up to (close to) the 128-bit precision of
+		// the computation, i.e. far beyond the float64 precision
+		tmp := new(Complex)
+		for i := range values {
+			tmp.Sub(&values[i], &want[i])
+			errReal, _ := tmp[0].Float64()
+			errImag, _ := tmp[1].Float64()
+			require.Less(t, math.Abs(errReal), math.Exp2(-100))
+			require.Less(t, math.Abs(errImag), math.Exp2(-100))
+		}
+	})
+}
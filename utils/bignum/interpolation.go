@@ -0,0 +1,203 @@
+package bignum
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// PolynomialFromSamples computes the unique polynomial of degree len(xs)-1 passing through
+// the points (xs[i], ys[i]) and returns it in the requested basis.
+// The nodes xs can follow any user-defined distribution (e.g. clustered close to a boundary)
+// but must be pairwise distinct.
+// If the basis is Chebyshev, the interval of the returned polynomial is [min(xs), max(xs)].
+// The reference precision is taken from the values stored in xs.
+func PolynomialFromSamples(xs, ys []big.Float, basis Basis) (pol *Polynomial, err error) {
+
+	n := len(xs)
+
+	if n == 0 {
+		return nil, fmt.Errorf("invalid inputs: empty sample list")
+	}
+
+	if len(ys) != n {
+		return nil, fmt.Errorf("invalid inputs: len(xs)=%d != len(ys)=%d", n, len(ys))
+	}
+
+	prec := xs[0].Prec()
+
+	a := new(big.Float).SetPrec(prec).Set(&xs[0])
+	b := new(big.Float).SetPrec(prec).Set(&xs[0])
+
+	for i := range xs {
+		if xs[i].Cmp(a) == -1 {
+			a.Set(&xs[i])
+		}
+		if xs[i].Cmp(b) == 1 {
+			b.Set(&xs[i])
+		}
+	}
+
+	switch basis {
+	case Monomial:
+
+		var coeffs []big.Float
+		if coeffs, err = interpolatingPolynomial(xs, ys, prec); err != nil {
+			return nil, err
+		}
+
+		return NewPolynomial(Monomial, coeffs, nil), nil
+
+	case Chebyshev:
+
+		interval := Interval{Nodes: n - 1, A: *a, B: *b}
+
+		if n == 1 {
+			// A single node defines the degree-0 interpolant; return it directly
+			// since the affine node mapping below is undefined on a zero-width interval.
+			coeffs := []big.Float{*new(big.Float).SetPrec(prec).Set(&ys[0])}
+			return NewPolynomial(Chebyshev, coeffs, &interval), nil
+		}
+
+		if a.Cmp(b) == 0 {
+			return nil, fmt.Errorf("invalid inputs: duplicate nodes")
+		}
+
+		// Maps the nodes to u = (2*x - (a+b))/(b-a) in [-1, 1]
+		us := make([]big.Float, n)
+
+		minusab := new(big.Float).Neg(a)
+		minusab.Sub(minusab, b)
+
+		bminusa := new(big.Float).Sub(b, a)
+
+		two := NewFloat(2, prec)
+
+		for i := range us {
+			us[i].SetPrec(prec).Mul(&xs[i], two)
+			us[i].Add(&us[i], minusab)
+			us[i].Quo(&us[i], bminusa)
+		}
+
+		var coeffs []big.Float
+		if coeffs, err = interpolatingPolynomial(us, ys, prec); err != nil {
+			return nil, err
+		}
+
+		return NewPolynomial(Chebyshev, monomialToChebyshev(coeffs, prec), &interval), nil
+
+	default:
+		return nil, fmt.Errorf("invalid basis type, allowed types are `Monomial` or `Chebyshev` but is %T", basis)
+	}
+}
+
+// interpolatingPolynomial computes the coefficients in the monomial basis of the unique
+// polynomial of degree len(xs)-1 passing through the points (xs[i], ys[i]) using Newton's
+// divided differences.
+func interpolatingPolynomial(xs, ys []big.Float, prec uint) (coeffs []big.Float, err error) {
+
+	n := len(xs)
+
+	// Divided differences, computed in place over a copy of ys
+	dd := make([]big.Float, n)
+	for i := range dd {
+		dd[i].SetPrec(prec).Set(&ys[i])
+	}
+
+	tmp := new(big.Float).SetPrec(prec)
+
+	for j := 1; j < n; j++ {
+		for i := n - 1; i >= j; i-- {
+			tmp.Sub(&xs[i], &xs[i-j])
+			if tmp.Sign() == 0 {
+				return nil, fmt.Errorf("invalid inputs: duplicate nodes")
+			}
+			dd[i].Sub(&dd[i], &dd[i-1])
+			dd[i].Quo(&dd[i], tmp)
+		}
+	}
+
+	// Expands the Newton form dd[0] + dd[1](x-xs[0]) + dd[2](x-xs[0])(x-xs[1]) + ...
+	// into monomial coefficients through a polynomial Horner scheme.
+	coeffs = make([]big.Float, n)
+	for i := range coeffs {
+		coeffs[i].SetPrec(prec)
+	}
+
+	coeffs[0].Set(&dd[n-1])
+
+	for i := n - 2; i >= 0; i-- {
+
+		// coeffs = coeffs * (x - xs[i]) + dd[i]
+		for j := n - 1 - i; j > 0; j-- {
+			tmp.Mul(&xs[i], &coeffs[j])
+			coeffs[j].Sub(&coeffs[j-1], tmp)
+		}
+
+		tmp.Mul(&xs[i], &coeffs[0])
+		coeffs[0].Sub(&dd[i], tmp)
+	}
+
+	return
+}
+
+// monomialToChebyshev converts coefficients in the monomial basis over [-1, 1] into
+// coefficients in the Chebyshev basis, by iteratively eliminating the leading monomial
+// with the Chebyshev polynomial of matching degree.
+func monomialToChebyshev(coeffs []big.Float, prec uint) (cheb []big.Float) {
+
+	n := len(coeffs)
+
+	// Monomial coefficients of T_0, ..., T_{n-1} through the
+	// recurrence T_{k+1} = 2x*T_{k} - T_{k-1}
+	T := make([][]big.Float, n)
+	for k := range T {
+		T[k] = make([]big.Float, k+1)
+		for i := range T[k] {
+			T[k][i].SetPrec(prec)
+		}
+	}
+
+	two := NewFloat(2, prec)
+	tmp := new(big.Float).SetPrec(prec)
+
+	T[0][0].SetInt64(1)
+
+	if n > 1 {
+		T[1][1].SetInt64(1)
+	}
+
+	for k := 2; k < n; k++ {
+		for i := 1; i < k+1; i++ {
+			T[k][i].Mul(&T[k-1][i-1], two)
+		}
+		for i := 0; i < k-1; i++ {
+			T[k][i].Sub(&T[k][i], &T[k-2][i])
+		}
+	}
+
+	rem := make([]big.Float, n)
+	for i := range rem {
+		rem[i].SetPrec(prec).Set(&coeffs[i])
+	}
+
+	cheb = make([]big.Float, n)
+	for i := range cheb {
+		cheb[i].SetPrec(prec)
+	}
+
+	for k := n - 1; k > 0; k-- {
+
+		// cheb[k] = rem[k] / leading(T_{k})
+		cheb[k].Quo(&rem[k], &T[k][k])
+
+		// rem = rem - cheb[k] * T_{k}
+		for i := 0; i < k+1; i++ {
+			tmp.Mul(&cheb[k], &T[k][i])
+			rem[i].Sub(&rem[i], tmp)
+		}
+	}
+
+	cheb[0].Set(&rem[0])
+
+	return
+}
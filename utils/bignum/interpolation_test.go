@@ -0,0 +1,55 @@
+package bignum
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Pro7ech/lattigo/utils/sampling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolynomialFromSamples(t *testing.T) {
+
+	prec := uint(128)
+
+	source := sampling.NewSource([32]byte{})
+
+	ref := NewPolynomial(Monomial, []float64{1, -2, 0.5, 3, -1.25, 0.75, -0.5, 2}, nil)
+
+	n := ref.Degree() + 1
+
+	xs := make([]big.Float, n)
+	ys := make([]big.Float, n)
+
+	for i := range xs {
+		x := NewFloat(source.Float64(-1, 1), prec)
+		y := ref.Evaluate(x)
+		xs[i].Set(x)
+		ys[i].Set(&y[0])
+	}
+
+	eps := new(big.Float).SetPrec(prec).SetFloat64(1e-25)
+	diff := new(big.Float).SetPrec(prec)
+
+	for _, basis := range []Basis{Monomial, Chebyshev} {
+
+		pol, err := PolynomialFromSamples(xs, ys, basis)
+		require.NoError(t, err)
+		require.Equal(t, ref.Degree(), pol.Degree())
+		require.Equal(t, basis, pol.Basis)
+
+		// A polynomial of matching degree must be recovered exactly
+		for i := 0; i < 16; i++ {
+			x := NewFloat(source.Float64(-1, 1), prec)
+			want := ref.Evaluate(x)
+			have := pol.Evaluate(x)
+			diff.Sub(&want[0], &have[0])
+			require.LessOrEqual(t, diff.Abs(diff).Cmp(eps), 0)
+		}
+	}
+
+	// Duplicate nodes must be rejected
+	xs[1].Set(&xs[0])
+	_, err := PolynomialFromSamples(xs, ys, Monomial)
+	require.Error(t, err)
+}
@@ -52,6 +52,15 @@ type RemezParameters struct {
 	// an optimal number of nodes per interval.
 	Intervals []Interval
 
+	// Weight is an optional positive weight function w(x) applied on the
+	// approximation error, i.e. the algorithm minimizes the maximum of
+	// |w(x) * (p(x) - f(x))| over the defined intervals instead of the
+	// maximum of |p(x) - f(x)|.
+	// Setting w(x) = 1/|f(x)| minimizes the relative error, which gives
+	// better approximations for functions spanning several orders of
+	// magnitude. If nil, a uniform weight of 1 is used.
+	Weight func(x *big.Float) (w *big.Float)
+
 	// Prec defines the bit precision of the overall computation.
 	Prec uint
 
@@ -225,11 +234,17 @@ func (r *Remez) getCoefficients() {
 		}
 	}
 
+	// The alternating column is ±1/w(xi) so that the solved E
+	// satisfies w(xi) * (p(xi) - f(xi)) = ±E at the nodes.
 	for i := 0; i < r.Degree+2; i++ {
+		c := &r.Matrix[i][r.Degree+1]
 		if i&1 == 0 {
-			r.Matrix[i][r.Degree+1].SetPrec(r.Prec).SetInt64(-1)
+			c.SetPrec(r.Prec).SetInt64(-1)
 		} else {
-			r.Matrix[i][r.Degree+1].SetPrec(r.Prec).SetInt64(1)
+			c.SetPrec(r.Prec).SetInt64(1)
+		}
+		if r.Weight != nil {
+			c.Quo(c, r.Weight(&r.Nodes[i].x))
 		}
 	}
 
@@ -254,12 +269,16 @@ func (r *Remez) findextrema() {
 
 	r.nbExtrema = 0
 
-	// e = p(x) - f(x) over [a, b]
+	// e = w(x) * (p(x) - f(x)) over [a, b]
 	fErr := func(x *big.Float) (y *big.Float) {
 		if y = r.Function(x); y == nil {
 			fmt.Println(x)
 		}
-		return y.Sub(r.eval(x), y)
+		y.Sub(r.eval(x), y)
+		if r.Weight != nil {
+			y.Mul(y, r.Weight(x))
+		}
+		return y
 	}
 
 	idx := 0
@@ -39,6 +39,50 @@ func TestApproximation(t *testing.T) {
 		require.InDelta(t, y0, y1, 1e-15)
 	})
 
+	t.Run("WeightedMinimaxRemez", func(t *testing.T) {
+
+		f := func(x *big.Float) (y *big.Float) {
+			return Exp(x)
+		}
+
+		// w(x) = 1/f(x): minimizes the relative error
+		w := func(x *big.Float) (y *big.Float) {
+			y = NewFloat(1, prec)
+			y.Quo(y, Exp(x))
+			return
+		}
+
+		intervals := []Interval{
+			{A: *NewFloat(-4, prec), B: *NewFloat(4, prec), Nodes: 31},
+		}
+
+		params := RemezParameters{
+			Function:  f,
+			Basis:     Chebyshev,
+			Intervals: intervals,
+			Weight:    w,
+			Prec:      prec,
+		}
+
+		r := NewRemez(params)
+		r.Approximate(200, 1e-15)
+
+		// The weighted error must equioscillate at the nodes
+		nErr := new(big.Float).Sub(r.MaxErr, r.MinErr)
+		nErr.Quo(nErr, r.MinErr)
+		require.Equal(t, -1, nErr.Cmp(NewFloat(1e-10, prec)))
+
+		// The relative error must be bounded by the weighted minimax error
+		bound, _ := new(big.Float).Mul(r.MaxErr, NewFloat(1.001, prec)).Float64()
+		for _, x := range []float64{-3.5, -1.0, 0.5, 3.9} {
+			xBig := NewFloat(x, prec)
+			err := new(big.Float).Sub(ChebyshevEval(xBig, r.Coeffs, intervals[0]), f(xBig))
+			err.Mul(err, w(xBig))
+			relErr, _ := err.Abs(err).Float64()
+			require.LessOrEqual(t, relErr, bound)
+		}
+	})
+
 	t.Run("MultiIntervalMinimaxRemez", func(t *testing.T) {
 
 		f := func(x *big.Float) (y *big.Float) {
@@ -0,0 +1,136 @@
+package sampling
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// NewSHAKESource returns a new [sampling.Source] whose output stream is the
+// SHAKE256 (FIPS 202) extendable output of the provided seed, read as a sequence
+// of little-endian 64-bit words.
+//
+// Unlike the default ChaCha8-backed source returned by [NewSource], a SHAKE-backed
+// source follows a public standard and can thus be reproduced by independently
+// written implementations, which is required to derive a common reference string
+// (e.g. the public polynomials of the mhe protocols) across implementations.
+// Seeds derived with [Source.NewSeed] and sources derived with [Source.NewSource]
+// from a SHAKE-backed source are themselves defined by the SHAKE256 stream.
+func NewSHAKESource(seed [32]byte) *Source {
+	return &Source{shake: newShakeState(seed[:])}
+}
+
+// rate of SHAKE256 in bytes: 1600/8 - 2*(256/8).
+const shakeRate = 136
+
+// shakeState implements the SHAKE256 sponge in squeezing mode over an absorbed seed.
+type shakeState struct {
+	a   [25]uint64
+	out [shakeRate]byte
+	pos int
+}
+
+// newShakeState returns a new shakeState with data absorbed and padded, ready to
+// be squeezed.
+func newShakeState(data []byte) (s *shakeState) {
+
+	s = &shakeState{pos: shakeRate}
+
+	for len(data) >= shakeRate {
+		for i := 0; i < shakeRate/8; i++ {
+			s.a[i] ^= binary.LittleEndian.Uint64(data[8*i:])
+		}
+		keccakF1600(&s.a)
+		data = data[shakeRate:]
+	}
+
+	var block [shakeRate]byte
+	copy(block[:], data)
+
+	// Domain separation and multi-rate padding (pad10*1)
+	block[len(data)] ^= 0x1f
+	block[shakeRate-1] ^= 0x80
+
+	for i := 0; i < shakeRate/8; i++ {
+		s.a[i] ^= binary.LittleEndian.Uint64(block[8*i:])
+	}
+
+	return
+}
+
+// Uint64 returns the next 64-bit word of the SHAKE256 output stream.
+func (s *shakeState) Uint64() (v uint64) {
+
+	if s.pos == shakeRate {
+		keccakF1600(&s.a)
+		for i := 0; i < shakeRate/8; i++ {
+			binary.LittleEndian.PutUint64(s.out[8*i:], s.a[i])
+		}
+		s.pos = 0
+	}
+
+	v = binary.LittleEndian.Uint64(s.out[s.pos:])
+	s.pos += 8
+	return
+}
+
+// keccakRC are the round constants of Keccak-f[1600].
+var keccakRC = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRho are the rotation offsets of Keccak-f[1600], indexed by x + 5y.
+var keccakRho = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation on the state a, whose lanes
+// are indexed by x + 5y.
+func keccakF1600(a *[25]uint64) {
+
+	var c, d [5]uint64
+	var b [25]uint64
+
+	for r := 0; r < 24; r++ {
+
+		// theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		}
+
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 25; y += 5 {
+				a[x+y] ^= d[x]
+			}
+		}
+
+		// rho and pi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], keccakRho[x+5*y])
+			}
+		}
+
+		// chi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 25; y += 5 {
+				a[x+y] = b[x+y] ^ (^b[(x+1)%5+y] & b[(x+2)%5+y])
+			}
+		}
+
+		// iota
+		a[0] ^= keccakRC[r]
+	}
+}
@@ -0,0 +1,65 @@
+package sampling
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SHAKE256 known-answer tests (FIPS 202).
+func TestSHAKE256(t *testing.T) {
+
+	for _, tc := range []struct {
+		data []byte
+		want string
+	}{
+		// SHAKE256("")
+		{[]byte{}, "46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762f"},
+		// SHAKE256(0x00 * 32)
+		{make([]byte, 32), "f5977c8283546a63723bc31d2619124f11db4658643336741df81757d5ad3062"},
+		// SHAKE256(0x01 || 0x02 || ... || 0x20)
+		{func() (data []byte) {
+			data = make([]byte, 32)
+			for i := range data {
+				data[i] = byte(i + 1)
+			}
+			return
+		}(), "f6a704e0fa1de28f681dd2fb0835391aadd2f114b6521c7bf69fc4f9e5de4ffe"},
+		// SHAKE256(0x00 * 200), input larger than the rate
+		{make([]byte, 200), "f5b4aefe08723672c27a362592cde8d2"},
+	} {
+		s := newShakeState(tc.data)
+		have := make([]byte, len(tc.want)>>1)
+		for i := 0; i < len(have); i += 8 {
+			v := s.Uint64()
+			for j := 0; j < 8; j++ {
+				have[i+j] = byte(v >> (8 * j))
+			}
+		}
+		require.Equal(t, tc.want, hex.EncodeToString(have))
+	}
+}
+
+func TestSHAKESource(t *testing.T) {
+
+	var seed [32]byte
+
+	// The output stream is the SHAKE256 extendable output of the seed
+	s := NewSHAKESource(seed)
+	require.Equal(t, uint64(0x636a5483827c97f5), s.Uint64()) // f5977c8283546a63 little-endian
+
+	// Deterministic seed branching
+	s0 := NewSHAKESource(seed)
+	s1 := NewSHAKESource(seed)
+	require.Equal(t, s0.NewSeed(), s1.NewSeed())
+
+	// Derived sources are SHAKE-backed
+	b0 := s0.NewSource()
+	b1 := s1.NewSource()
+	require.NotNil(t, b0.shake)
+	require.Equal(t, b0.Uint64(), b1.Uint64())
+
+	// Distinct from the default ChaCha8-backed source
+	require.NotEqual(t, NewSource(seed).Uint64(), NewSHAKESource(seed).Uint64())
+}
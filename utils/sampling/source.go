@@ -17,7 +17,8 @@ func NewSeed() (seed [32]byte) {
 }
 
 type Source struct {
-	buff [8]byte
+	buff  [8]byte
+	shake *shakeState
 	rand.ChaCha8
 }
 
@@ -25,6 +26,15 @@ func NewSource(seed [32]byte) *Source {
 	return &Source{ChaCha8: *rand.NewChaCha8(seed)}
 }
 
+// Uint64 returns the next 64-bit word of the source, generated by ChaCha8
+// or, if the receiver was instantiated with [NewSHAKESource], by SHAKE256.
+func (s *Source) Uint64() uint64 {
+	if s.shake != nil {
+		return s.shake.Uint64()
+	}
+	return s.ChaCha8.Uint64()
+}
+
 // NewSeed returns a new seed populated with bytes
 // generated by the receiver.
 func (s *Source) NewSeed() (seed [32]byte) {
@@ -36,7 +46,11 @@ func (s *Source) NewSeed() (seed [32]byte) {
 
 // NewSource returns a new [sampling.Source] seeded
 // with bytes generated by the receiver.
+// The new source uses the same backing as the receiver.
 func (s *Source) NewSource() *Source {
+	if s.shake != nil {
+		return NewSHAKESource(s.NewSeed())
+	}
 	return NewSource(s.NewSeed())
 }
 
@@ -52,6 +66,28 @@ func (s *Source) Complex128(min, max complex128) complex128 {
 	return complex(s.Float64(real(min), real(max)), s.Float64(imag(min), imag(max)))
 }
 
+// Permutation returns a uniformly sampled permutation of [0, n) (Fisher-Yates).
+func (s *Source) Permutation(n int) (perm []int) {
+	perm = make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	s.Shuffle(n, func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+	return
+}
+
+// Shuffle pseudo-randomly shuffles the order of n elements through the swap function,
+// which exchanges the elements with indexes i and j.
+func (s *Source) Shuffle(n int, swap func(i, j int)) {
+	rand.New(s).Shuffle(n, swap)
+}
+
+// Shuffle pseudo-randomly shuffles the elements of slice using the
+// provided [sampling.Source].
+func Shuffle[T any](s *Source, slice []T) {
+	s.Shuffle(len(slice), func(i, j int) { slice[i], slice[j] = slice[j], slice[i] })
+}
+
 // Read complies to the io.Reader interface.
 func (s *Source) Read(b []byte) (n int, err error) {
 